@@ -0,0 +1,100 @@
+package mercadopago
+
+import (
+	"errors"
+	"fmt"
+)
+
+// BinMatch is a payment method whose settings match with the first digits of a card.
+type BinMatch struct {
+	PaymentMethod PaymentMethod
+	Settings      Settings
+	// Installments report if the card can pay in installments, using the installments pattern of the bin.
+	Installments bool
+}
+
+// BinIndex detect the payment method of a card from its first digits (the BIN).
+// All the patterns are compiled when the index is built, so it's safe to use it on every keystroke
+// of a checkout form, and from many goroutines at the same time.
+type BinIndex struct {
+	entries []binEntry
+	errs    []error
+}
+
+type binEntry struct {
+	method   PaymentMethod
+	settings Settings
+	pattern  *binPattern
+}
+
+// NewBinIndex compile the bin patterns of all the payment methods with settings. The settings with a pattern
+// that can't be compiled are skipped and reported by Errors, so one unsupported pattern of the API doesn't
+// break the detection of the other cards. It only return an error when none of the patterns compiled.
+func NewBinIndex(methods PaymentMethods) (*BinIndex, error) {
+	var idx BinIndex
+	for _, method := range methods {
+		for _, settings := range method.Settings {
+			if settings.Bin.Pattern == "" {
+				continue
+			}
+			pattern, err := compileBin(settings.Bin)
+			if err != nil {
+				idx.errs = append(idx.errs, fmt.Errorf("payment method %s: %w", method.ID, err))
+				continue
+			}
+			idx.entries = append(idx.entries, binEntry{
+				method:   method,
+				settings: settings,
+				pattern:  pattern,
+			})
+		}
+	}
+
+	if len(idx.entries) == 0 && len(idx.errs) > 0 {
+		return nil, fmt.Errorf("no bin pattern could be compiled: %w", errors.Join(idx.errs...))
+	}
+
+	return &idx, nil
+}
+
+// Errors return the errors of the bin patterns skipped when the index was built.
+func (idx *BinIndex) Errors() []error {
+	return idx.errs
+}
+
+// Lookup return the payment methods that match with the bin, usually the first 6 to 8 digits of the card,
+// but it also work with the full card number.
+func (idx *BinIndex) Lookup(bin string) []BinMatch {
+	return idx.lookup([]byte(bin))
+}
+
+// lookup is like Lookup with the bytes of the card number, so ValidateCard can use the bytes of the
+// SensitiveString without copying the card number.
+func (idx *BinIndex) lookup(bin []byte) []BinMatch {
+	if !isDigits(bin) {
+		return nil
+	}
+
+	var result []BinMatch
+	for _, e := range idx.entries {
+		if !e.pattern.match(bin) {
+			continue
+		}
+		result = append(result, BinMatch{
+			PaymentMethod: e.method,
+			Settings:      e.settings,
+			Installments:  e.pattern.installments != nil && e.pattern.installments.Match(bin),
+		})
+	}
+
+	return result
+}
+
+// PaymentMethod return the first payment method that match with the bin.
+func (idx *BinIndex) PaymentMethod(bin string) (*BinMatch, bool) {
+	matches := idx.Lookup(bin)
+	if len(matches) == 0 {
+		return nil, false
+	}
+	return &matches[0], true
+}
@@ -0,0 +1,117 @@
+package mercadopago_test
+
+import (
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestBinIndex(t *testing.T) {
+
+	idx, err := mercadopago.NewBinIndex(loadPaymentMethods(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name                 string
+		bin                  string
+		expectedMethods      []string
+		expectedInstallments bool
+	}{
+		{
+			name:                 "Visa credit card",
+			bin:                  "450995",
+			expectedMethods:      []string{"visa"},
+			expectedInstallments: true,
+		},
+		{
+			name:                 "Visa without installments",
+			bin:                  "427836",
+			expectedMethods:      []string{"visa"},
+			expectedInstallments: false,
+		},
+		{
+			name:            "Visa debit card excluded from visa",
+			bin:             "400276",
+			expectedMethods: []string{"debvisa"},
+		},
+		{
+			name:                 "Co-branded card excluded from master",
+			bin:                  "55703912",
+			expectedMethods:      []string{"cmr"},
+			expectedInstallments: true,
+		},
+		{
+			name:            "Diners excluded bin",
+			bin:             "364612",
+			expectedMethods: nil,
+		},
+		{
+			name:            "Invalid bin",
+			bin:             "4509a",
+			expectedMethods: nil,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+			got := idx.Lookup(tt.bin)
+			if len(got) != len(tt.expectedMethods) {
+				t.Fatalf("Expected methods %v but receive %d matches", tt.expectedMethods, len(got))
+			}
+			for i, id := range tt.expectedMethods {
+				if got[i].PaymentMethod.ID != id {
+					t.Fatalf("Expected method %s but receive %s", id, got[i].PaymentMethod.ID)
+				}
+				if got[i].Installments != tt.expectedInstallments {
+					t.Fatalf("Expected installments %t but receive %t", tt.expectedInstallments, got[i].Installments)
+				}
+			}
+		})
+	}
+
+	if _, ok := idx.PaymentMethod("000000"); ok {
+		t.Fatal("Unknown bin should not match any payment method")
+	}
+}
+
+func TestBinIndexSkipInvalidPatterns(t *testing.T) {
+
+	methods := mercadopago.PaymentMethods{
+		{ID: "visa", Settings: []mercadopago.Settings{{Bin: mercadopago.Bin{Pattern: "^4"}}}},
+		{ID: "master", Settings: []mercadopago.Settings{{Bin: mercadopago.Bin{Pattern: "^5", ExclusionPattern: "^(5(?!1))"}}}},
+		{ID: "amex", Settings: []mercadopago.Settings{{Bin: mercadopago.Bin{Pattern: "^3[47]", InstallmentsPattern: "^3(?!4)"}}}},
+	}
+
+	idx, err := mercadopago.NewBinIndex(methods)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(idx.Errors()) != 2 {
+		t.Fatalf("Expected 2 skipped patterns but receive: %v", idx.Errors())
+	}
+	if match, ok := idx.PaymentMethod("450995"); !ok || match.PaymentMethod.ID != "visa" {
+		t.Fatalf("Valid patterns should still match, receive: %+v", match)
+	}
+	if _, ok := idx.PaymentMethod("510000"); ok {
+		t.Fatal("Skipped pattern should not match")
+	}
+
+	if _, err := mercadopago.NewBinIndex(methods[1:]); err == nil {
+		t.Fatal("Index without valid patterns should return an error")
+	}
+}
+
+func BenchmarkBinIndexLookup(b *testing.B) {
+	idx, err := mercadopago.NewBinIndex(loadPaymentMethods(b))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		idx.Lookup("45099535")
+	}
+}
@@ -0,0 +1,94 @@
+package mercadopago
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type Issuer struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	SecureThumbnail   string `json:"secure_thumbnail"`
+	Thumbnail         string `json:"thumbnail"`
+	ProcessingMode    string `json:"processing_mode,omitempty"`
+	MerchantAccountID string `json:"merchant_account_id,omitempty"`
+	Status            string `json:"status,omitempty"`
+}
+
+// CardIssuers return the issuers of the payment method, the bin is optional and it filter the issuers of the card.
+func (c *Client) CardIssuers(ctx context.Context, paymentMethodID, bin string) ([]Issuer, error) {
+
+	q := url.Values{}
+	q.Set("payment_method_id", paymentMethodID)
+	if bin != "" {
+		q.Set("bin", bin)
+	}
+
+	url := fmt.Sprintf("%sv1/payment_methods/card_issuers?%s", c.BaseURL, q.Encode())
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return nil, &errRes
+		}
+
+		return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	var issuers []Issuer
+	if err := json.NewDecoder(res.Body).Decode(&issuers); err != nil {
+		return nil, errors.New("Can't parse response")
+	}
+
+	return issuers, nil
+}
+
+// CardDetection is the payment method detected from the bin of a card, with the issuers to choose.
+type CardDetection struct {
+	BinMatch
+	// Issuers is empty when the payment method doesn't need the issuer.
+	Issuers []Issuer
+	// Issuer is the issuer selected by default, when the bin only match with one issuer.
+	Issuer *Issuer
+}
+
+// DetectCard find the payment method of the bin with the index, and when the method need the issuer_id
+// it fetch the issuers of the card, so the issuer of a form can be pre-filled.
+func (c *Client) DetectCard(ctx context.Context, idx *BinIndex, bin string) (*CardDetection, error) {
+	match, ok := idx.PaymentMethod(bin)
+	if !ok {
+		return nil, fmt.Errorf("no payment method match with the bin %s", bin)
+	}
+
+	detection := CardDetection{BinMatch: *match}
+	if !match.PaymentMethod.RequiresInfo(InfoIssuerID) {
+		return &detection, nil
+	}
+
+	issuers, err := c.CardIssuers(ctx, match.PaymentMethod.ID, bin)
+	if err != nil {
+		return nil, err
+	}
+	detection.Issuers = issuers
+	if len(issuers) == 1 {
+		detection.Issuer = &issuers[0]
+	}
+
+	return &detection, nil
+}
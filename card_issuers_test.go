@@ -0,0 +1,92 @@
+package mercadopago_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestCardIssuers(t *testing.T) {
+
+	var rightResponse string = `
+        [
+          {
+            "id": "3",
+            "name": "Mastercard",
+            "secure_thumbnail": "https://www.mercadopago.com/org-img/MP3/API/logos/master.gif",
+            "thumbnail": "http://img.mlstatic.com/org-img/MP3/API/logos/master.gif",
+            "processing_mode": "aggregator",
+            "merchant_account_id": null,
+            "status": "active"
+          }
+        ]
+        `
+	var response []mercadopago.Issuer
+	if err := json.NewDecoder(strings.NewReader(rightResponse)).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if http.MethodGet != r.Method {
+			t.Fatalf("HTTP Method expected is %s but receive %s", http.MethodGet, r.Method)
+		}
+		urlPAth := "/v1/payment_methods/card_issuers"
+		if urlPAth != r.URL.Path {
+			t.Fatalf("Path URL expected is %s but receive %s", urlPAth, r.URL.Path)
+		}
+		expectedQuery := "bin=503175&payment_method_id=master"
+		if expectedQuery != r.URL.RawQuery {
+			t.Fatalf("Query expected is %s but receive %s", expectedQuery, r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(rightResponse))
+	}))
+	defer server.Close()
+
+	client := mercadopago.NewClient(server.URL+"/", accessToken)
+	got, err := client.CardIssuers(context.Background(), "master", "503175")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, response) {
+		t.Fatalf("Expected result is %v but receive %v", response, got)
+	}
+
+	idx, err := mercadopago.NewBinIndex(loadPaymentMethods(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Master need the issuer, so it's pre-filled with the only issuer of the bin
+	detection, err := client.DetectCard(context.Background(), idx, "503175")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detection.PaymentMethod.ID != "master" || detection.Issuer == nil || detection.Issuer.ID != "3" {
+		t.Fatalf("Expected master with issuer 3 but receive %+v", detection)
+	}
+
+	// Visa doesn't need the issuer, so the API is not called
+	detection, err = client.DetectCard(context.Background(), idx, "450995")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detection.PaymentMethod.ID != "visa" || detection.Issuer != nil || requests != 2 {
+		t.Fatalf("Expected visa without issuer but receive %+v", detection)
+	}
+
+	if _, err := client.DetectCard(context.Background(), idx, "000000"); err == nil {
+		t.Fatal("Unknown bin should return an error")
+	}
+}
@@ -7,52 +7,240 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"time"
 )
 
 type RequestCardToken struct {
-	CardNumber      string `json:"card_number"`
-	ExpirationMonth int    `json:"expiration_month"`
-	ExpirationYear  int    `json:"expiration_year"`
-	SecurityCode    string `json:"security_code"`
-	Cardholder      struct {
-		Name string `json:"name"`
-	} `json:"cardholder"`
+	CardNumber      SensitiveString `json:"card_number"`
+	ExpirationMonth int             `json:"expiration_month"`
+	ExpirationYear  int             `json:"expiration_year"`
+	SecurityCode    SensitiveString `json:"security_code"`
+	Cardholder      Cardholder      `json:"cardholder"`
+	// RequireEsc ask the API for an ESC (Encrypted Security Code), so the card can be tokenized again without the security code.
+	RequireEsc bool `json:"require_esc,omitempty"`
+}
+
+// RequestSavedCardToken is the request to create a card token from a card saved in a customer.
+type RequestSavedCardToken struct {
+	CardID       string          `json:"card_id"`
+	SecurityCode SensitiveString `json:"security_code,omitempty"`
+	Esc          string          `json:"esc,omitempty"`
+	RequireEsc   bool            `json:"require_esc,omitempty"`
+}
+
+// Validate check that the request has the card ID and the security code or the ESC.
+func (r RequestSavedCardToken) Validate() error {
+	var errs ValidationErrors
+	if r.CardID == "" {
+		errs = append(errs, ValidationError{Field: "card_id", Message: "is required"})
+	}
+	if r.SecurityCode.IsZero() && r.Esc == "" {
+		errs = append(errs, ValidationError{Field: "security_code", Message: "security code or ESC is required"})
+	} else if !r.SecurityCode.IsZero() && !r.SecurityCode.isDigits() {
+		errs = append(errs, ValidationError{Field: "security_code", Message: "must contain only digits"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
 }
 
 type CardToken struct {
-	ID              string `json:"id"`
-	FirstSixDigits  string `json:"first_six_digits"`
-	ExpirationMonth int    `json:"expiration_month"`
-	ExpirationYear  int    `json:"expiration_year"`
-	LastFourDigits  string `json:"last_four_digits"`
-	Cardholder      struct {
-		Identification struct {
-		} `json:"identification"`
-	} `json:"cardholder"`
-	Status             string `json:"status"`
-	DateCreated        string `json:"date_created"`
-	DateLastUpdated    string `json:"date_last_updated"`
-	DateDue            string `json:"date_due"`
-	LuhnValidation     bool   `json:"luhn_validation"`
-	LiveMode           bool   `json:"live_mode"`
-	RequireEsc         bool   `json:"require_esc"`
-	CardNumberLength   int    `json:"card_number_length"`
-	SecurityCodeLength int    `json:"security_code_length"`
+	ID                 string          `json:"id"`
+	FirstSixDigits     string          `json:"first_six_digits"`
+	ExpirationMonth    int             `json:"expiration_month"`
+	ExpirationYear     int             `json:"expiration_year"`
+	LastFourDigits     string          `json:"last_four_digits"`
+	Cardholder         Cardholder      `json:"cardholder"`
+	Status             CardTokenStatus `json:"status"`
+	DateCreated        string          `json:"date_created"`
+	DateLastUpdated    string          `json:"date_last_updated"`
+	DateDue            string          `json:"date_due"`
+	LuhnValidation     bool            `json:"luhn_validation"`
+	LiveMode           bool            `json:"live_mode"`
+	RequireEsc         bool            `json:"require_esc"`
+	CardNumberLength   int             `json:"card_number_length"`
+	SecurityCodeLength int             `json:"security_code_length"`
+	// Esc is only returned when the token was requested with RequireEsc, it must be stored with the card ID
+	// to create the next tokens of the card without asking the security code again.
+	Esc string `json:"esc,omitempty"`
+}
+
+// SavedCardTokenRequest return the request to tokenize again the saved card with the ESC of this token,
+// for one-click checkouts. The second value is false when the token doesn't have an ESC.
+func (t *CardToken) SavedCardTokenRequest(cardID string) (RequestSavedCardToken, bool) {
+	if t.Esc == "" {
+		return RequestSavedCardToken{}, false
+	}
+	return RequestSavedCardToken{CardID: cardID, Esc: t.Esc, RequireEsc: true}, true
+}
+
+type CardTokenStatus string
+
+const (
+	CardTokenStatusActive  CardTokenStatus = "active"
+	CardTokenStatusUsed    CardTokenStatus = "used"
+	CardTokenStatusExpired CardTokenStatus = "expired"
+)
+
+// DueDate return the date when the token expires.
+func (t *CardToken) DueDate() (time.Time, error) {
+	return time.Parse(time.RFC3339, t.DateDue)
+}
+
+// IsUsable report if the token can still be used to make a payment at the given time.
+// A token can be used only once, so after a payment it will have the status used.
+func (t *CardToken) IsUsable(now time.Time) bool {
+	if t.Status != CardTokenStatusActive {
+		return false
+	}
+	due, err := t.DueDate()
+	if err != nil {
+		return false
+	}
+	return now.Before(due)
+}
+
+// encode build the JSON body with the raw card number and security code, the fields of type SensitiveString
+// are always redacted by json.Marshal. The body is built by hand in a single buffer with the exact size, so the
+// raw values aren't copied to the buffers of the encoder, and the caller must wipe the result after sending it.
+func (r RequestCardToken) encode() ([]byte, error) {
+	cardholder, err := json.Marshal(r.Cardholder)
+	if err != nil {
+		return nil, err
+	}
+	tail := fmt.Sprintf(`,"expiration_month":%d,"expiration_year":%d,"cardholder":%s`, r.ExpirationMonth, r.ExpirationYear, cardholder)
+	if r.RequireEsc {
+		tail += `,"require_esc":true`
+	}
+
+	const cardNumberField, securityCodeField = `{"card_number":`, `,"security_code":`
+	body := make([]byte, 0, len(cardNumberField)+r.CardNumber.jsonLen()+len(securityCodeField)+r.SecurityCode.jsonLen()+len(tail)+1)
+	body = append(body, cardNumberField...)
+	body = r.CardNumber.appendJSON(body)
+	body = append(body, securityCodeField...)
+	body = r.SecurityCode.appendJSON(body)
+	body = append(body, tail...)
+	return append(body, '}'), nil
+}
+
+// encode build the JSON body with the raw security code, like RequestCardToken.encode.
+// The caller must wipe the result after sending it.
+func (r RequestSavedCardToken) encode() ([]byte, error) {
+	head, err := json.Marshal(struct {
+		CardID     string `json:"card_id"`
+		Esc        string `json:"esc,omitempty"`
+		RequireEsc bool   `json:"require_esc,omitempty"`
+	}{
+		CardID:     r.CardID,
+		Esc:        r.Esc,
+		RequireEsc: r.RequireEsc,
+	})
+	if err != nil || r.SecurityCode.IsZero() {
+		return head, err
+	}
+
+	const securityCodeField = `,"security_code":`
+	head = head[:len(head)-1]
+	body := make([]byte, 0, len(head)+len(securityCodeField)+r.SecurityCode.jsonLen()+1)
+	body = append(body, head...)
+	body = append(body, securityCodeField...)
+	body = r.SecurityCode.appendJSON(body)
+	return append(body, '}'), nil
 }
 
 // GetCardToken will retrieve all the data from the credit card, including the ID necessary to make the payments.
+// After sending the request the card number and the security code are wiped, so the request can't be used again.
+// When the request isn't sent, like with an environment error or a network error, they aren't wiped so the
+// request can be retried, and the caller must wipe them when giving up.
 func (c *Client) GetCardToken(ctx context.Context, data RequestCardToken) (*CardToken, error) {
 
-	body, err := json.Marshal(data)
+	body, err := data.encode()
+	if err != nil {
+		return nil, err
+	}
+	defer wipe(body)
+
+	return c.createCardToken(ctx, body, func() {
+		data.CardNumber.Wipe()
+		data.SecurityCode.Wipe()
+	})
+}
+
+// GetSavedCardToken create a card token from a card saved in a customer, for returning customers.
+// The request needs the security code of the card, or the ESC returned by a previous token with RequireEsc.
+// The security code is wiped after sending the request, like in GetCardToken.
+func (c *Client) GetSavedCardToken(ctx context.Context, data RequestSavedCardToken) (*CardToken, error) {
+
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	body, err := data.encode()
 	if err != nil {
 		return nil, err
 	}
+	defer wipe(body)
+
+	return c.createCardToken(ctx, body, data.SecurityCode.Wipe)
+}
+
+// createCardToken send the request of the card token, and call sent after the API received it to wipe the card
+// data of the caller. A production client reject the tokens in test mode, but a sandbox client accept the tokens
+// in live mode, because the API return live_mode true for the tokens created with test credentials too.
+func (c *Client) createCardToken(ctx context.Context, body []byte, sent func()) (*CardToken, error) {
+
+	if err := c.checkEnvironment(); err != nil {
+		return nil, err
+	}
+
 	url := fmt.Sprintf("%sv1/card_tokens", c.BaseURL)
 
 	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	sent()
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return nil, &errRes
+		}
+
+		return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	var cardToken CardToken
+	if err := json.NewDecoder(res.Body).Decode(&cardToken); err != nil {
+		return nil, errors.New("Can't parse response")
+	}
+
+	if err := c.checkCardTokenLiveMode(cardToken.LiveMode); err != nil {
+		return &cardToken, err
+	}
+
+	return &cardToken, nil
+}
+
+// GetCardTokenByID retrieve a card token created before, so we can check if it's still usable.
+// The ID is escaped, so it can't change the path of the request.
+func (c *Client) GetCardTokenByID(ctx context.Context, id string) (*CardToken, error) {
+
+	url := fmt.Sprintf("%sv1/card_tokens/%s", c.BaseURL, url.PathEscape(id))
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	req = req.WithContext(ctx)
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jackgris/mercadopago"
 )
@@ -42,23 +43,19 @@ func TestGetCreditCard(t *testing.T) {
 		t.Fatal(err)
 	}
 	validCardToken := mercadopago.RequestCardToken{
-		CardNumber:      "5031235734530705",
+		CardNumber:      mercadopago.NewSensitiveString("5031235734530705"),
 		ExpirationMonth: 10,
 		ExpirationYear:  2024,
-		SecurityCode:    "123",
-		Cardholder: struct {
-			Name string `json:"name"`
-		}{Name: ""},
+		SecurityCode:    mercadopago.NewSensitiveString("123"),
+		Cardholder:      mercadopago.Cardholder{Name: ""},
 	}
 
 	invalidCardToken := mercadopago.RequestCardToken{
-		CardNumber:      "503123573453",
+		CardNumber:      mercadopago.NewSensitiveString("503123573453"),
 		ExpirationMonth: 18,
 		ExpirationYear:  2000,
-		SecurityCode:    "1234",
-		Cardholder: struct {
-			Name string `json:"name"`
-		}{Name: ""},
+		SecurityCode:    mercadopago.NewSensitiveString("1234"),
+		Cardholder:      mercadopago.Cardholder{Name: ""},
 	}
 
 	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
@@ -166,7 +163,7 @@ func TestGetCreditCard(t *testing.T) {
 					return
 				}
 
-				if len(reqCard.CardNumber) != response.CardNumberLength {
+				if reqCard.CardNumber.Len() != response.CardNumberLength {
 					w.WriteHeader(tt.respStatus)
 					data, _ := json.Marshal(tt.expectedErr)
 					_, _ = w.Write(data)
@@ -190,3 +187,259 @@ func TestGetCreditCard(t *testing.T) {
 	}
 
 }
+
+func TestGetCardTokenByID(t *testing.T) {
+
+	var rightResponse string = `
+         {
+            "id": "234bc93745ac08281fdc7dba4aa4567b",
+            "first_six_digits": "503123",
+            "expiration_month": 10,
+            "expiration_year": 2024,
+            "last_four_digits": "0704",
+            "cardholder": {
+              "identification": {}
+            },
+            "status": "used",
+            "date_created": "2023-08-29T23:11:19.758-04:00",
+            "date_last_updated": "2023-08-29T23:15:19.758-04:00",
+            "date_due": "2023-09-06T23:11:19.758-04:00",
+            "luhn_validation": true,
+            "live_mode": false,
+            "require_esc": false,
+            "card_number_length": 16,
+            "security_code_length": 3
+         }
+        `
+	var response mercadopago.CardToken
+	if err := json.NewDecoder(strings.NewReader(rightResponse)).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+
+	tests := []struct {
+		name             string
+		id               string
+		expectedResponse *mercadopago.CardToken
+		expectedErr      *mercadopago.ErrorResponse
+	}{
+		{
+			name:             "Successful response",
+			id:               response.ID,
+			expectedResponse: &response,
+			expectedErr:      nil,
+		},
+		{
+			name:             "Not found",
+			id:               "ffffffffffffffffffffffffffffffff",
+			expectedResponse: nil,
+			expectedErr: &mercadopago.ErrorResponse{
+				Message: "card_token not found",
+				Errors:  "not_found",
+				Status:  http.StatusNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if http.MethodGet != r.Method {
+					t.Fatalf("HTTP Method expected is %s but receive %s", http.MethodGet, r.Method)
+				}
+
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				urlPAth := "/v1/card_tokens/" + response.ID
+				if urlPAth != r.URL.Path {
+					w.WriteHeader(http.StatusNotFound)
+					data, _ := json.Marshal(tt.expectedErr)
+					_, _ = w.Write(data)
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(rightResponse))
+			}))
+			defer server.Close()
+
+			client := mercadopago.NewClient(server.URL+"/", accessToken)
+			got, err := client.GetCardTokenByID(context.Background(), tt.id)
+			if err != nil && errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Receive error: %s | must be: %s", err, tt.expectedErr)
+			}
+			if !reflect.DeepEqual(got, tt.expectedResponse) {
+				t.Fatalf("Expected result is %v but receive %v", tt.expectedResponse, got)
+			}
+		})
+	}
+}
+
+func TestGetCardTokenByIDEscapeID(t *testing.T) {
+
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		urlPAth := "/v1/card_tokens/..%2Fpayments%2F1%3Fstatus=approved"
+		if urlPAth != r.URL.EscapedPath() {
+			t.Fatalf("Path URL expected is %s but receive %s", urlPAth, r.URL.EscapedPath())
+		}
+		if r.URL.RawQuery != "" {
+			t.Fatalf("Expected no query but receive %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "card_token not found", "error": "not_found", "status": 404, "cause": []}`))
+	}))
+	defer server.Close()
+
+	client := mercadopago.NewClient(server.URL+"/", accessToken)
+	_, err := client.GetCardTokenByID(context.Background(), "../payments/1?status=approved")
+	var errRes *mercadopago.ErrorResponse
+	if !errors.As(err, &errRes) || errRes.Status != http.StatusNotFound {
+		t.Fatalf("Expected a not found error but receive: %v", err)
+	}
+}
+
+func TestCardTokenIsUsable(t *testing.T) {
+
+	due := "2023-09-06T23:11:19.758-04:00"
+	before := time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)
+	after := time.Date(2023, 9, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		token    mercadopago.CardToken
+		now      time.Time
+		expected bool
+	}{
+		{
+			name:     "Active token",
+			token:    mercadopago.CardToken{Status: mercadopago.CardTokenStatusActive, DateDue: due},
+			now:      before,
+			expected: true,
+		},
+		{
+			name:     "Active token after due date",
+			token:    mercadopago.CardToken{Status: mercadopago.CardTokenStatusActive, DateDue: due},
+			now:      after,
+			expected: false,
+		},
+		{
+			name:     "Used token",
+			token:    mercadopago.CardToken{Status: mercadopago.CardTokenStatusUsed, DateDue: due},
+			now:      before,
+			expected: false,
+		},
+		{
+			name:     "Expired token",
+			token:    mercadopago.CardToken{Status: mercadopago.CardTokenStatusExpired, DateDue: due},
+			now:      before,
+			expected: false,
+		},
+		{
+			name:     "Without due date",
+			token:    mercadopago.CardToken{Status: mercadopago.CardTokenStatusActive},
+			now:      before,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.token.IsUsable(tt.now); got != tt.expected {
+				t.Fatalf("Expected usable %t but receive %t", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGetSavedCardToken(t *testing.T) {
+
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+	esc := "5b0c8a3bcd9e4f6a8b1c2d3e4f5a6b7c"
+
+	tests := []struct {
+		name        string
+		request     mercadopago.RequestSavedCardToken
+		expectedEsc string
+		expectedErr bool
+	}{
+		{
+			name:        "With security code",
+			request:     mercadopago.RequestSavedCardToken{CardID: "9053893640", SecurityCode: mercadopago.NewSensitiveString("123"), RequireEsc: true},
+			expectedEsc: esc,
+		},
+		{
+			name:        "With ESC",
+			request:     mercadopago.RequestSavedCardToken{CardID: "9053893640", Esc: esc, RequireEsc: true},
+			expectedEsc: esc,
+		},
+		{
+			name:        "Without security code and ESC",
+			request:     mercadopago.RequestSavedCardToken{CardID: "9053893640"},
+			expectedErr: true,
+		},
+		{
+			name:        "Without card ID",
+			request:     mercadopago.RequestSavedCardToken{SecurityCode: mercadopago.NewSensitiveString("123")},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if http.MethodPost != r.Method {
+					t.Fatalf("HTTP Method expected is %s but receive %s", http.MethodPost, r.Method)
+				}
+				urlPAth := "/v1/card_tokens"
+				if urlPAth != r.URL.Path {
+					t.Fatalf("Path URL expected is %s but receive %s", urlPAth, r.URL.Path)
+				}
+
+				var reqCard mercadopago.RequestSavedCardToken
+				if err := json.NewDecoder(r.Body).Decode(&reqCard); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(reqCard, tt.request) {
+					t.Fatalf("Expected request is %v but receive %v", tt.request, reqCard)
+				}
+
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = fmt.Fprintf(w, `{"id": "234bc93745ac08281fdc7dba4aa4567b", "status": "active", "require_esc": true, "esc": "%s"}`, esc)
+			}))
+			defer server.Close()
+
+			client := mercadopago.NewClient(server.URL+"/", accessToken)
+			got, err := client.GetSavedCardToken(context.Background(), tt.request)
+			if tt.expectedErr {
+				var errs mercadopago.ValidationErrors
+				if !errors.As(err, &errs) {
+					t.Fatalf("Expected validation errors but receive: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Esc != tt.expectedEsc {
+				t.Fatalf("Expected ESC %s but receive %s", tt.expectedEsc, got.Esc)
+			}
+			if strings.Trim(tt.request.SecurityCode.Reveal(), "\x00") != "" {
+				t.Fatal("Security code must be wiped after sending the request")
+			}
+
+			next, ok := got.SavedCardTokenRequest(tt.request.CardID)
+			if !ok || next.Esc != esc || !next.SecurityCode.IsZero() {
+				t.Fatalf("Expected a request with the ESC but receive %v", next)
+			}
+		})
+	}
+}
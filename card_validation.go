@@ -0,0 +1,234 @@
+package mercadopago
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ValidationError is the error of one field of a request validated before calling the API.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors is the list of fields with errors of a request.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Field return the error of a field, or nil if the field is valid.
+func (e ValidationErrors) Field(field string) *ValidationError {
+	for i := range e {
+		if e[i].Field == field {
+			return &e[i]
+		}
+	}
+	return nil
+}
+
+// ValidateCard check the card data against the settings of the payment method that match with the card number,
+// so we can reject invalid cards before calling GetCardToken. When the card is invalid the error is ValidationErrors.
+func ValidateCard(card RequestCardToken, methods PaymentMethods) error {
+	idx, err := NewBinIndex(methods)
+	if err != nil {
+		return err
+	}
+	return idx.ValidateCard(card)
+}
+
+// ValidateCard is like the function ValidateCard but use the patterns already compiled in the index.
+func (idx *BinIndex) ValidateCard(card RequestCardToken) error {
+	var errs ValidationErrors
+
+	// The card data is read from the bytes of the SensitiveString, without copying it to strings that can't be wiped.
+	number := card.CardNumber.buf
+	if !isDigits(number) {
+		errs = append(errs, ValidationError{Field: "card_number", Message: "must contain only digits"})
+	}
+	if !card.SecurityCode.IsZero() && !card.SecurityCode.isDigits() {
+		errs = append(errs, ValidationError{Field: "security_code", Message: "must contain only digits"})
+	}
+	errs = append(errs, validateExpiration(card.ExpirationMonth, card.ExpirationYear, time.Now())...)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	candidates := idx.lookup(number)
+	if len(candidates) == 0 {
+		return ValidationErrors{{Field: "card_number", Message: "doesn't match any payment method"}}
+	}
+
+	// The same card number can match more than one payment method, for example a generic and a co-branded card,
+	// it's valid if the card satisfies the settings of any of them.
+	var first ValidationErrors
+	for i, match := range candidates {
+		errs := validateCardSettings(card, match.Settings)
+		if len(errs) == 0 {
+			return nil
+		}
+		if i == 0 {
+			first = errs
+		}
+	}
+
+	return first
+}
+
+func validateCardSettings(card RequestCardToken, settings Settings) ValidationErrors {
+	var errs ValidationErrors
+
+	number := card.CardNumber.buf
+	if settings.CardNumber.Length > 0 && len(number) != settings.CardNumber.Length {
+		errs = append(errs, ValidationError{
+			Field:   "card_number",
+			Message: fmt.Sprintf("must have %d digits", settings.CardNumber.Length),
+		})
+	} else if settings.CardNumber.Validation == "standard" && !luhn(number) {
+		errs = append(errs, ValidationError{Field: "card_number", Message: "invalid check digit"})
+	}
+
+	code := card.SecurityCode
+	switch {
+	case code.IsZero() && settings.SecurityCode.Mode == "mandatory":
+		errs = append(errs, ValidationError{Field: "security_code", Message: "is required"})
+	case !code.IsZero() && settings.SecurityCode.Length > 0 && code.Len() != settings.SecurityCode.Length:
+		errs = append(errs, ValidationError{
+			Field:   "security_code",
+			Message: fmt.Sprintf("must have %d digits", settings.SecurityCode.Length),
+		})
+	}
+
+	return errs
+}
+
+func validateExpiration(month, year int, now time.Time) ValidationErrors {
+	var errs ValidationErrors
+
+	if month < 1 || month > 12 {
+		errs = append(errs, ValidationError{Field: "expiration_month", Message: "must be between 1 and 12"})
+	}
+	// Some forms send the year with only two digits
+	if year < 100 {
+		year += 2000
+	}
+	if year < now.Year() {
+		errs = append(errs, ValidationError{Field: "expiration_year", Message: "card is expired"})
+	} else if len(errs) == 0 && year == now.Year() && month < int(now.Month()) {
+		errs = append(errs, ValidationError{Field: "expiration_month", Message: "card is expired"})
+	}
+
+	return errs
+}
+
+// binPattern is the compiled version of the patterns in Bin.
+type binPattern struct {
+	pattern      *regexp.Regexp
+	exclusion    *regexp.Regexp
+	installments *negatableRegexp
+}
+
+func compileBin(bin Bin) (*binPattern, error) {
+	if bin.Pattern == "" {
+		return nil, fmt.Errorf("empty bin pattern")
+	}
+
+	var p binPattern
+	var err error
+	if p.pattern, err = regexp.Compile(bin.Pattern); err != nil {
+		return nil, err
+	}
+	if bin.ExclusionPattern != "" {
+		if p.exclusion, err = regexp.Compile(bin.ExclusionPattern); err != nil {
+			return nil, err
+		}
+	}
+	if bin.InstallmentsPattern != "" {
+		if p.installments, err = compileNegatable(bin.InstallmentsPattern); err != nil {
+			return nil, err
+		}
+	}
+
+	return &p, nil
+}
+
+func (p *binPattern) match(number []byte) bool {
+	if !p.pattern.Match(number) {
+		return false
+	}
+	return p.exclusion == nil || !p.exclusion.Match(number)
+}
+
+// negatableRegexp support the patterns of the API with a negative lookahead like ^(?!(123|456)),
+// they are not supported by the regexp package so we compile the inner pattern and negate the result.
+type negatableRegexp struct {
+	re     *regexp.Regexp
+	negate bool
+}
+
+func compileNegatable(pattern string) (*negatableRegexp, error) {
+	var n negatableRegexp
+	if strings.HasPrefix(pattern, "^(?!") && strings.HasSuffix(pattern, ")") {
+		n.negate = true
+		pattern = "^" + strings.TrimSuffix(strings.TrimPrefix(pattern, "^(?!"), ")")
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	n.re = re
+
+	return &n, nil
+}
+
+func (n *negatableRegexp) Match(b []byte) bool {
+	return n.re.Match(b) != n.negate
+}
+
+// luhn validate the check digit of a card number.
+func luhn(number []byte) bool {
+	if len(number) == 0 {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// isDigits report if s has only digits, it accepts the bytes of a SensitiveString so they aren't copied.
+func isDigits[T string | []byte](s T) bool {
+	if len(s) == 0 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
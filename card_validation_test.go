@@ -0,0 +1,143 @@
+package mercadopago_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func loadPaymentMethods(t testing.TB) mercadopago.PaymentMethods {
+	t.Helper()
+
+	data, err := os.ReadFile("./testdata/payment_methods_data.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paymentMethods mercadopago.PaymentMethods
+	if err := json.Unmarshal(data, &paymentMethods); err != nil {
+		t.Fatal(err)
+	}
+
+	return paymentMethods
+}
+
+func TestValidateCard(t *testing.T) {
+
+	paymentMethods := loadPaymentMethods(t)
+	year := time.Now().Year() + 2
+
+	tests := []struct {
+		name           string
+		card           mercadopago.RequestCardToken
+		expectedFields []string
+	}{
+		{
+			name: "Valid master card",
+			card: mercadopago.RequestCardToken{
+				CardNumber:      mercadopago.NewSensitiveString("5031755734530604"),
+				ExpirationMonth: 11,
+				ExpirationYear:  year,
+				SecurityCode:    mercadopago.NewSensitiveString("123"),
+			},
+		},
+		{
+			name: "Valid amex card",
+			card: mercadopago.RequestCardToken{
+				CardNumber:      mercadopago.NewSensitiveString("371180303257522"),
+				ExpirationMonth: 11,
+				ExpirationYear:  year,
+				SecurityCode:    mercadopago.NewSensitiveString("1234"),
+			},
+		},
+		{
+			name: "Invalid check digit",
+			card: mercadopago.RequestCardToken{
+				CardNumber:      mercadopago.NewSensitiveString("5031755734530605"),
+				ExpirationMonth: 11,
+				ExpirationYear:  year,
+				SecurityCode:    mercadopago.NewSensitiveString("123"),
+			},
+			expectedFields: []string{"card_number"},
+		},
+		{
+			name: "Wrong length and security code",
+			card: mercadopago.RequestCardToken{
+				CardNumber:      mercadopago.NewSensitiveString("4509953566233"),
+				ExpirationMonth: 11,
+				ExpirationYear:  year,
+				SecurityCode:    mercadopago.NewSensitiveString("12"),
+			},
+			expectedFields: []string{"card_number", "security_code"},
+		},
+		{
+			name: "Missing mandatory security code",
+			card: mercadopago.RequestCardToken{
+				CardNumber:      mercadopago.NewSensitiveString("4509953566233704"),
+				ExpirationMonth: 11,
+				ExpirationYear:  year,
+			},
+			expectedFields: []string{"security_code"},
+		},
+		{
+			name: "Expired card",
+			card: mercadopago.RequestCardToken{
+				CardNumber:      mercadopago.NewSensitiveString("4509953566233704"),
+				ExpirationMonth: 13,
+				ExpirationYear:  2000,
+				SecurityCode:    mercadopago.NewSensitiveString("123"),
+			},
+			expectedFields: []string{"expiration_month", "expiration_year"},
+		},
+		{
+			name: "Unknown card",
+			card: mercadopago.RequestCardToken{
+				CardNumber:      mercadopago.NewSensitiveString("9999999999999995"),
+				ExpirationMonth: 11,
+				ExpirationYear:  year,
+				SecurityCode:    mercadopago.NewSensitiveString("123"),
+			},
+			expectedFields: []string{"card_number"},
+		},
+		{
+			name: "Not digits",
+			card: mercadopago.RequestCardToken{
+				CardNumber:      mercadopago.NewSensitiveString("4509 9535 6623 3704"),
+				ExpirationMonth: 11,
+				ExpirationYear:  year,
+				SecurityCode:    mercadopago.NewSensitiveString("12a"),
+			},
+			expectedFields: []string{"card_number", "security_code"},
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := mercadopago.ValidateCard(tt.card, paymentMethods)
+			if len(tt.expectedFields) == 0 {
+				if err != nil {
+					t.Fatalf("Card should be valid but receive: %s", err)
+				}
+				return
+			}
+
+			var errs mercadopago.ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("Expected validation errors but receive: %v", err)
+			}
+			if len(errs) != len(tt.expectedFields) {
+				t.Fatalf("Expected errors in %v but receive: %s", tt.expectedFields, errs)
+			}
+			for _, field := range tt.expectedFields {
+				if errs.Field(field) == nil {
+					t.Fatalf("Expected error in field %s but receive: %s", field, errs)
+				}
+			}
+		})
+	}
+}
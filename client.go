@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -14,10 +15,13 @@ const (
 
 // Client is the API client
 type Client struct {
-	token         string
-	BaseURL       string
-	HTTPClient    *http.Client
-	HTTPTransport transport
+	token           string
+	publicKey       string
+	environment     Environment
+	allowProduction bool
+	BaseURL         string
+	HTTPClient      *http.Client
+	HTTPTransport   transport
 }
 
 // NewClient create a new Client for API interaction
@@ -50,17 +54,60 @@ func NewClient(rawURL, token string) *Client {
 	return &c
 }
 
+// NewPublicKeyClient create a new Client that use the public key instead of the access token.
+// It can only call the endpoints that are safe for a frontend, like the card tokenization, the payment methods,
+// the installments and the issuers, the other endpoints return ErrAccessTokenRequired.
+func NewPublicKeyClient(rawURL, publicKey string) *Client {
+	c := NewClient(rawURL, "")
+	if c == nil {
+		return nil
+	}
+
+	c.publicKey = publicKey
+	c.HTTPTransport.publicKey = publicKey
+	c.HTTPTransport.header.Del("Authorization")
+	c.HTTPClient.Transport = c.HTTPTransport
+
+	return c
+}
+
+// IsPublicKey report if the client use a public key instead of an access token.
+func (c *Client) IsPublicKey() bool {
+	return c.publicKey != ""
+}
+
 func (c *Client) RefreshToken(token string) {
 	c.token = token
 	c.HTTPTransport.header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 }
 
 type transport struct {
-	header  http.Header
-	baseUrl url.URL
+	header    http.Header
+	baseUrl   url.URL
+	publicKey string
+}
+
+// publicKeyEndpoints are the endpoints that accept the public key, with the allowed HTTP method.
+var publicKeyEndpoints = map[string]string{
+	"v1/card_tokens":                  http.MethodPost,
+	"v1/payment_methods":              http.MethodGet,
+	"v1/payment_methods/search":       http.MethodGet,
+	"v1/payment_methods/installments": http.MethodGet,
+	"v1/payment_methods/card_issuers": http.MethodGet,
+	"v1/identification_types":         http.MethodGet,
 }
 
 func (t transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if t.publicKey != "" {
+		endpoint := strings.TrimPrefix(request.URL.Path, t.baseUrl.Path)
+		if method, ok := publicKeyEndpoints[endpoint]; !ok || method != request.Method {
+			return nil, fmt.Errorf("%w: %s %s", ErrAccessTokenRequired, request.Method, endpoint)
+		}
+		q := request.URL.Query()
+		q.Set("public_key", t.publicKey)
+		request.URL.RawQuery = q.Encode()
+	}
+
 	for headerName, values := range t.header {
 		for _, val := range values {
 			request.Header.Add(headerName, val)
@@ -1,6 +1,10 @@
 package mercadopago_test
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/jackgris/mercadopago"
@@ -17,3 +21,49 @@ func TestNewClient(t *testing.T) {
 		t.Error("Client should not be nil because receive a right string as base URL.")
 	}
 }
+
+func TestNewPublicKeyClient(t *testing.T) {
+
+	publicKey := "TEST-b3d5b663-664a-4e8f-b759-de5d7c12ef8f"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Fatalf("Public key client must not send the Authorization header, receive %s", r.Header.Get("Authorization"))
+		}
+		if got := r.URL.Query().Get("public_key"); got != publicKey {
+			t.Fatalf("Public key expected is %s but receive %s", publicKey, got)
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c := mercadopago.NewPublicKeyClient(server.URL+"/", publicKey)
+	if c == nil || !c.IsPublicKey() {
+		t.Fatal("Client should use the public key")
+	}
+
+	ctx := context.Background()
+	if _, err := c.PaymentMethods(ctx); err != nil {
+		t.Fatalf("Payment methods should be allowed with the public key, receive: %s", err)
+	}
+	if _, err := c.Installments(ctx, mercadopago.RequestInstallments{Amount: 100, Bin: "450995"}); err != nil {
+		t.Fatalf("Installments should be allowed with the public key, receive: %s", err)
+	}
+
+	if _, err := c.Me(ctx); !errors.Is(err, mercadopago.ErrAccessTokenRequired) {
+		t.Fatalf("Receive error: %v | must be: %v", err, mercadopago.ErrAccessTokenRequired)
+	}
+	if _, err := c.GetCardTokenByID(ctx, "234bc93745ac08281fdc7dba4aa4567b"); !errors.Is(err, mercadopago.ErrAccessTokenRequired) {
+		t.Fatalf("Receive error: %v | must be: %v", err, mercadopago.ErrAccessTokenRequired)
+	}
+
+	if err := c.SetEnvironment(mercadopago.EnvironmentProduction); err != nil {
+		t.Fatal(err)
+	}
+	c.AllowProduction()
+	if _, err := c.GetCardToken(ctx, mercadopago.RequestCardToken{}); !errors.Is(err, mercadopago.ErrEnvironmentMismatch) {
+		t.Fatalf("Receive error: %v | must be: %v", err, mercadopago.ErrEnvironmentMismatch)
+	}
+}
@@ -0,0 +1,132 @@
+// Command mercadopago has tools to work with the Mercado Pago API.
+//
+// Usage:
+//
+//	mercadopago diff [-json] [-site MLA] -fetch
+//	mercadopago diff [-json] [-site MLA] [old.json] new.json
+//
+// The diff subcommand compare two copies of the payment methods. With two files it compare them,
+// with one file it compare the snapshot of the site embedded in the package with the file, and with -fetch it
+// compare the snapshot with the payment methods returned by the API, using the access token of the ACCESS_TOKEN
+// environment variable, -fetch can't be used with files. It exit with status 1 when there are changes, so it can
+// be used for alerts.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "diff":
+		changed, err := diff(os.Args[2:], os.Stdout)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "mercadopago diff:", err)
+			os.Exit(2)
+		}
+		if changed {
+			os.Exit(1)
+		}
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: mercadopago diff [-json] [-site MLA] -fetch")
+	fmt.Fprintln(os.Stderr, "       mercadopago diff [-json] [-site MLA] [old.json] new.json")
+}
+
+// diff run the diff subcommand and report if there are changes.
+func diff(args []string, out io.Writer) (bool, error) {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	asJSON := fs.Bool("json", false, "print the changes as JSON")
+	fetch := fs.Bool("fetch", false, "compare the embedded snapshot with the payment methods returned by the API")
+	site := fs.String("site", "MLA", "site of the embedded snapshot used as the old payment methods")
+	if err := fs.Parse(args); err != nil {
+		return false, err
+	}
+
+	var before, after mercadopago.PaymentMethods
+	var err error
+	switch {
+	case *fetch && fs.NArg() > 0:
+		return false, fmt.Errorf("-fetch can't be used with files")
+	case *fetch:
+		if before, err = mercadopago.SnapshotPaymentMethods(*site); err != nil {
+			return false, err
+		}
+		if after, err = fetchPaymentMethods(); err != nil {
+			return false, err
+		}
+	case fs.NArg() == 1:
+		if before, err = mercadopago.SnapshotPaymentMethods(*site); err != nil {
+			return false, err
+		}
+		if after, err = loadFile(fs.Arg(0)); err != nil {
+			return false, err
+		}
+	case fs.NArg() == 2:
+		if before, err = loadFile(fs.Arg(0)); err != nil {
+			return false, err
+		}
+		if after, err = loadFile(fs.Arg(1)); err != nil {
+			return false, err
+		}
+	default:
+		return false, fmt.Errorf("expected -fetch, one file or two files")
+	}
+
+	d := mercadopago.DiffPaymentMethods(before, after)
+	if *asJSON {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "    ")
+		if err := enc.Encode(d); err != nil {
+			return false, err
+		}
+	} else {
+		fmt.Fprint(out, d)
+	}
+
+	return !d.IsEmpty(), nil
+}
+
+func loadFile(name string) (mercadopago.PaymentMethods, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	methods, err := mercadopago.LoadPaymentMethods(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return methods, nil
+}
+
+func fetchPaymentMethods() (mercadopago.PaymentMethods, error) {
+	token := os.Getenv("ACCESS_TOKEN")
+	if token == "" {
+		return nil, fmt.Errorf("the ACCESS_TOKEN environment variable is empty")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	return mercadopago.NewClient(mercadopago.BaseURL, token).PaymentMethods(ctx)
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestDiff(t *testing.T) {
+
+	snapshot := filepath.Join("..", "..", "testdata", "payment_methods_data.json")
+	methods, err := mercadopago.SnapshotPaymentMethods("MLA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	changed := filepath.Join(t.TempDir(), "changed.json")
+	data, _ := json.Marshal(methods[1:])
+	if err := os.WriteFile(changed, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	hasChanges, err := diff([]string{snapshot, snapshot}, &out)
+	if err != nil || hasChanges {
+		t.Fatalf("Same files should not have changes, receive %v: %s", err, out.String())
+	}
+
+	out.Reset()
+	hasChanges, err = diff([]string{changed}, &out)
+	if err != nil || !hasChanges {
+		t.Fatalf("Expected changes against the snapshot, receive %v: %s", err, out.String())
+	}
+	if !strings.Contains(out.String(), "- tarshop: removed") {
+		t.Fatalf("Unexpected report: %s", out.String())
+	}
+
+	out.Reset()
+	if _, err = diff([]string{"-json", snapshot, changed}, &out); err != nil {
+		t.Fatal(err)
+	}
+	var d mercadopago.PaymentMethodsDiff
+	if err := json.Unmarshal(out.Bytes(), &d); err != nil || len(d.Removed) != 1 {
+		t.Fatalf("Unexpected JSON report %v: %s", err, out.String())
+	}
+
+	if _, err := diff(nil, &out); err == nil {
+		t.Fatal("Diff without files should return an error")
+	}
+
+	t.Setenv("ACCESS_TOKEN", "")
+	for _, args := range [][]string{{"-fetch", changed}, {"-fetch", snapshot, changed}} {
+		_, err := diff(args, &out)
+		if err == nil || !strings.Contains(err.Error(), "-fetch") {
+			t.Fatalf("Diff with %v should return a usage error, receive %v", args, err)
+		}
+	}
+}
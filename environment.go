@@ -0,0 +1,107 @@
+package mercadopago
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Environment string
+
+const (
+	// EnvironmentSandbox only allow requests with test credentials.
+	EnvironmentSandbox Environment = "sandbox"
+	// EnvironmentProduction only allow requests with production credentials, and needs
+	// an explicit opt-in with AllowProduction before the client can create real charges.
+	EnvironmentProduction Environment = "production"
+)
+
+// SetEnvironment restrict the client to sandbox or production requests.
+// Without an environment the client doesn't check the credentials at all.
+func (c *Client) SetEnvironment(env Environment) error {
+	if env != EnvironmentSandbox && env != EnvironmentProduction {
+		return fmt.Errorf("unknown environment: %q", env)
+	}
+	c.environment = env
+	return nil
+}
+
+// Environment return the environment configured in the client.
+func (c *Client) Environment() Environment {
+	return c.environment
+}
+
+// AllowProduction is the explicit opt-in to create real charges with a client in production environment.
+func (c *Client) AllowProduction() {
+	c.allowProduction = true
+}
+
+// checkEnvironment verify that the token used by the client match with the environment configured.
+// It must be called before every request that can create or change a charge.
+func (c *Client) checkEnvironment() error {
+	if c.environment == "" {
+		return nil
+	}
+
+	isTest, err := c.isTestCredential()
+	if err != nil {
+		return err
+	}
+
+	switch c.environment {
+	case EnvironmentSandbox:
+		if !isTest {
+			return fmt.Errorf("%w: sandbox client can't use a production token", ErrEnvironmentMismatch)
+		}
+	case EnvironmentProduction:
+		if isTest {
+			return fmt.Errorf("%w: production client can't use a test token", ErrEnvironmentMismatch)
+		}
+		if !c.allowProduction {
+			return ErrProductionNotAllowed
+		}
+	}
+
+	return nil
+}
+
+// isTestCredential report if the access token or the public key of the client are test credentials.
+// The public keys have the same prefix than the access tokens, but without the user ID.
+func (c *Client) isTestCredential() (bool, error) {
+	if c.IsPublicKey() {
+		switch {
+		case strings.HasPrefix(c.publicKey, string(TokenTypeTest)+"-"):
+			return true, nil
+		case strings.HasPrefix(c.publicKey, string(TokenTypeProduction)+"-"):
+			return false, nil
+		}
+		return false, ErrInvalidToken
+	}
+
+	info, err := ParseToken(c.token)
+	if err != nil {
+		return false, err
+	}
+	return info.IsTest(), nil
+}
+
+// checkLiveMode verify that the live mode reported by the API match with the environment configured.
+// It's only reliable for payments, the card tokens report live mode even with test credentials, use
+// checkCardTokenLiveMode for them.
+func (c *Client) checkLiveMode(liveMode bool) error {
+	switch {
+	case c.environment == EnvironmentSandbox && liveMode:
+		return fmt.Errorf("%w: sandbox client received a live mode response", ErrEnvironmentMismatch)
+	case c.environment == EnvironmentProduction && !liveMode:
+		return fmt.Errorf("%w: production client received a test mode response", ErrEnvironmentMismatch)
+	}
+	return nil
+}
+
+// checkCardTokenLiveMode verify the live mode of a card token. The tokens created with test credentials can
+// report live mode too, so only a test mode token received by a production client is a mismatch.
+func (c *Client) checkCardTokenLiveMode(liveMode bool) error {
+	if c.environment == EnvironmentProduction && !liveMode {
+		return fmt.Errorf("%w: production client received a test mode card token", ErrEnvironmentMismatch)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package mercadopago_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestEnvironment(t *testing.T) {
+
+	testToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+	prodToken := "APP_USR-7237385876897478-882419-cf8589ace9fee57cb876a2dc72ed88a6-57883988"
+
+	tests := []struct {
+		name            string
+		accessToken     string
+		environment     mercadopago.Environment
+		allowProduction bool
+		liveMode        bool
+		expectedErr     error
+	}{
+		{
+			name:        "Sandbox with test token",
+			accessToken: testToken,
+			environment: mercadopago.EnvironmentSandbox,
+			liveMode:    false,
+			expectedErr: nil,
+		},
+		{
+			name:        "Sandbox with production token",
+			accessToken: prodToken,
+			environment: mercadopago.EnvironmentSandbox,
+			expectedErr: mercadopago.ErrEnvironmentMismatch,
+		},
+		{
+			name:        "Sandbox with live mode card token",
+			accessToken: testToken,
+			environment: mercadopago.EnvironmentSandbox,
+			liveMode:    true,
+			expectedErr: nil,
+		},
+		{
+			name:        "Production without opt-in",
+			accessToken: prodToken,
+			environment: mercadopago.EnvironmentProduction,
+			liveMode:    true,
+			expectedErr: mercadopago.ErrProductionNotAllowed,
+		},
+		{
+			name:            "Production with opt-in",
+			accessToken:     prodToken,
+			environment:     mercadopago.EnvironmentProduction,
+			allowProduction: true,
+			liveMode:        true,
+			expectedErr:     nil,
+		},
+		{
+			name:            "Production with test mode card token",
+			accessToken:     prodToken,
+			environment:     mercadopago.EnvironmentProduction,
+			allowProduction: true,
+			liveMode:        false,
+			expectedErr:     mercadopago.ErrEnvironmentMismatch,
+		},
+		{
+			name:            "Production with test token",
+			accessToken:     testToken,
+			environment:     mercadopago.EnvironmentProduction,
+			allowProduction: true,
+			expectedErr:     mercadopago.ErrEnvironmentMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = fmt.Fprintf(w, `{"id": "234bc93745ac08281fdc7dba4aa4567b", "live_mode": %t}`, tt.liveMode)
+			}))
+			defer server.Close()
+
+			client := mercadopago.NewClient(server.URL+"/", tt.accessToken)
+			if err := client.SetEnvironment(tt.environment); err != nil {
+				t.Fatal(err)
+			}
+			if tt.allowProduction {
+				client.AllowProduction()
+			}
+
+			_, err := client.GetCardToken(context.Background(), mercadopago.RequestCardToken{})
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Receive error: %v | must be: %v", err, tt.expectedErr)
+			}
+		})
+	}
+
+	client := mercadopago.NewClient(mercadopago.BaseURL, testToken)
+	if err := client.SetEnvironment("staging"); err == nil {
+		t.Fatal("Unknown environment should return an error")
+	}
+}
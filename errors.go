@@ -1,6 +1,33 @@
 package mercadopago
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidToken is returned when an access token doesn't have the format used by Mercado Pago
+var ErrInvalidToken = errors.New("invalid access token format")
+
+// ErrEnvironmentMismatch is returned when the credentials or the response don't match the client environment
+var ErrEnvironmentMismatch = errors.New("environment mismatch")
+
+// ErrProductionNotAllowed is returned when a production client try to make a request without the explicit opt-in
+var ErrProductionNotAllowed = errors.New("production requests are not allowed, call AllowProduction to enable them")
+
+// ErrAccessTokenRequired is returned when a client with a public key call an endpoint that needs the access token
+var ErrAccessTokenRequired = errors.New("endpoint requires an access token")
+
+// ErrInvalidPaymentStatus is returned when a payment can't be changed because of its status
+var ErrInvalidPaymentStatus = errors.New("invalid payment status")
+
+// ErrRefundExceedsRemaining is returned when a partial refund is greater than the amount that can still be refunded
+var ErrRefundExceedsRemaining = errors.New("refund exceeds the remaining refundable amount")
+
+// ErrSnapshotNotFound is returned when the package doesn't have a copy of the payment methods of a site
+var ErrSnapshotNotFound = errors.New("payment methods snapshot not found")
+
+// ErrCurrencyMismatch is returned when amounts of different currencies are added together
+var ErrCurrencyMismatch = errors.New("currency mismatch")
 
 // ErrorResponse represent the error message that the API return
 type ErrorResponse struct {
@@ -0,0 +1,101 @@
+package mercadopago
+
+import "fmt"
+
+// FeeType is the concept of a fee of the payment.
+type FeeType string
+
+const (
+	FeeTypeMercadoPago FeeType = "mercadopago_fee"
+	FeeTypeFinancing   FeeType = "financing_fee"
+	FeeTypeShipping    FeeType = "shipping_fee"
+	FeeTypeApplication FeeType = "application_fee"
+	FeeTypeDiscount    FeeType = "discount_fee"
+	FeeTypeCoupon      FeeType = "coupon_fee"
+)
+
+// FeePayer is who pays a fee of the payment.
+type FeePayer string
+
+const (
+	FeePayerCollector FeePayer = "collector"
+	FeePayerPayer     FeePayer = "payer"
+)
+
+// FeeBreakdown is the sum of the amounts and the fees of a set of payments of one currency, in Money so the
+// totals are exact.
+type FeeBreakdown struct {
+	// CurrencyID is the currency of all the payments, like ARS.
+	CurrencyID string
+	Payments   int
+	// TransactionAmount is the amount of the payments, without the fees paid by the payer.
+	TransactionAmount Money
+	// TotalPaid is the amount paid by the payers, including the fees paid by them like the financing fee.
+	TotalPaid Money
+	// NetReceived is the amount received by the collector after the fees paid by the collector.
+	NetReceived Money
+	// CollectorFees and PayerFees are the fees paid by the collector and by the payers.
+	CollectorFees Money
+	PayerFees     Money
+	// Fees is the sum of the fees of each type.
+	Fees map[FeeType]Money
+}
+
+// Fee return the sum of the fees of the type, like FeeTypeMercadoPago.
+func (b FeeBreakdown) Fee(feeType FeeType) Money {
+	return b.Fees[feeType]
+}
+
+// Add add the amounts and the fees of the payment to the breakdown. The first payment set the currency of the
+// breakdown, and the payments of other currencies return ErrCurrencyMismatch without being added.
+func (b *FeeBreakdown) Add(payment Payment) error {
+	if b.CurrencyID == "" && b.Payments == 0 {
+		b.CurrencyID = payment.CurrencyID
+	}
+	if payment.CurrencyID != b.CurrencyID {
+		return fmt.Errorf("%w: payment %d is in %s and the breakdown in %s", ErrCurrencyMismatch, payment.ID, payment.CurrencyID, b.CurrencyID)
+	}
+	if b.Fees == nil {
+		b.Fees = map[FeeType]Money{}
+	}
+
+	b.Payments++
+	b.TransactionAmount += NewMoney(payment.TransactionAmount)
+	b.TotalPaid += NewMoney(payment.TransactionDetails.TotalPaidAmount)
+	b.NetReceived += NewMoney(payment.TransactionDetails.NetReceivedAmount)
+
+	for _, fee := range payment.FeeDetails {
+		amount := NewMoney(fee.Amount)
+		b.Fees[fee.Type] += amount
+		switch fee.FeePayer {
+		case FeePayerCollector:
+			b.CollectorFees += amount
+		case FeePayerPayer:
+			b.PayerFees += amount
+		}
+	}
+	return nil
+}
+
+// SummarizeFees return the sum of the amounts and the fees of the payments by currency, grouping the fees by type.
+// All the payments are added, so the caller must filter them before, for example keeping the approved ones.
+func SummarizeFees(payments []Payment) map[string]FeeBreakdown {
+	breakdowns := map[string]FeeBreakdown{}
+	for _, payment := range payments {
+		breakdown, ok := breakdowns[payment.CurrencyID]
+		if !ok {
+			breakdown = FeeBreakdown{CurrencyID: payment.CurrencyID, Fees: map[FeeType]Money{}}
+		}
+		// the currency always match, so it can't fail
+		_ = breakdown.Add(payment)
+		breakdowns[payment.CurrencyID] = breakdown
+	}
+	return breakdowns
+}
+
+// FeeBreakdown return the amounts and the fees of the payment.
+func (p *Payment) FeeBreakdown() FeeBreakdown {
+	breakdown := FeeBreakdown{CurrencyID: p.CurrencyID, Fees: map[FeeType]Money{}}
+	_ = breakdown.Add(*p)
+	return breakdown
+}
@@ -0,0 +1,104 @@
+package mercadopago_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestFeeBreakdown(t *testing.T) {
+
+	var payment mercadopago.Payment
+	if err := json.Unmarshal([]byte(paymentResponse), &payment); err != nil {
+		t.Fatal(err)
+	}
+
+	breakdown := payment.FeeBreakdown()
+	if breakdown.Payments != 1 {
+		t.Fatalf("Expected 1 payment but receive %d", breakdown.Payments)
+	}
+	if breakdown.TransactionAmount != mercadopago.NewMoney(1000) ||
+		breakdown.TotalPaid != mercadopago.NewMoney(1200) ||
+		breakdown.NetReceived != mercadopago.NewMoney(1131.4) {
+		t.Fatalf("Unexpected amounts %+v", breakdown)
+	}
+	if breakdown.Fee(mercadopago.FeeTypeMercadoPago) != mercadopago.NewMoney(68.6) ||
+		breakdown.Fee(mercadopago.FeeTypeFinancing) != mercadopago.NewMoney(200) {
+		t.Fatalf("Unexpected fees %+v", breakdown.Fees)
+	}
+	if breakdown.CollectorFees != mercadopago.NewMoney(68.6) || breakdown.PayerFees != mercadopago.NewMoney(200) {
+		t.Fatalf("Unexpected fees by payer %+v", breakdown)
+	}
+
+	second := mercadopago.Payment{
+		ID:                2,
+		CurrencyID:        "ARS",
+		TransactionAmount: 100.1,
+		TransactionDetails: mercadopago.TransactionDetails{
+			NetReceivedAmount: 93.2,
+			TotalPaidAmount:   100.1,
+		},
+		FeeDetails: []mercadopago.FeeDetail{
+			{Type: mercadopago.FeeTypeMercadoPago, FeePayer: mercadopago.FeePayerCollector, Amount: 4.9},
+			{Type: mercadopago.FeeTypeApplication, FeePayer: mercadopago.FeePayerCollector, Amount: 2},
+		},
+	}
+
+	brl := mercadopago.Payment{
+		ID:                 3,
+		CurrencyID:         "BRL",
+		TransactionAmount:  50,
+		TransactionDetails: mercadopago.TransactionDetails{NetReceivedAmount: 47.5, TotalPaidAmount: 50},
+		FeeDetails:         []mercadopago.FeeDetail{{Type: mercadopago.FeeTypeMercadoPago, FeePayer: mercadopago.FeePayerCollector, Amount: 2.5}},
+	}
+
+	summary := mercadopago.SummarizeFees([]mercadopago.Payment{payment, second, brl, {CurrencyID: "ARS"}})
+	if len(summary) != 2 {
+		t.Fatalf("Expected a breakdown for ARS and BRL but receive %v", summary)
+	}
+	if brlBreakdown := summary["BRL"]; brlBreakdown.CurrencyID != "BRL" || brlBreakdown.Payments != 1 || brlBreakdown.Fee(mercadopago.FeeTypeMercadoPago) != mercadopago.NewMoney(2.5) {
+		t.Fatalf("Unexpected BRL breakdown %+v", brlBreakdown)
+	}
+	total := summary["ARS"]
+
+	tests := []struct {
+		name     string
+		got      mercadopago.Money
+		expected float64
+	}{
+		{name: "Transaction amount", got: total.TransactionAmount, expected: 1100.1},
+		{name: "Total paid", got: total.TotalPaid, expected: 1300.1},
+		{name: "Net received", got: total.NetReceived, expected: 1224.6},
+		{name: "Mercado Pago fee", got: total.Fee(mercadopago.FeeTypeMercadoPago), expected: 73.5},
+		{name: "Financing fee", got: total.Fee(mercadopago.FeeTypeFinancing), expected: 200},
+		{name: "Application fee", got: total.Fee(mercadopago.FeeTypeApplication), expected: 2},
+		{name: "Shipping fee", got: total.Fee(mercadopago.FeeTypeShipping), expected: 0},
+		{name: "Collector fees", got: total.CollectorFees, expected: 75.5},
+		{name: "Payer fees", got: total.PayerFees, expected: 200},
+	}
+
+	for _, tt := range tests {
+		if tt.got != mercadopago.NewMoney(tt.expected) {
+			t.Fatalf("%s expected %.2f but receive %s", tt.name, tt.expected, tt.got)
+		}
+	}
+	if total.Payments != 3 {
+		t.Fatalf("Expected 3 payments but receive %d", total.Payments)
+	}
+
+	var empty mercadopago.FeeBreakdown
+	if err := empty.Add(second); err != nil {
+		t.Fatal(err)
+	}
+	if empty.CurrencyID != "ARS" || empty.Fee(mercadopago.FeeTypeMercadoPago) != mercadopago.NewMoney(4.9) {
+		t.Fatalf("Unexpected breakdown %+v", empty)
+	}
+	if err := empty.Add(brl); !errors.Is(err, mercadopago.ErrCurrencyMismatch) {
+		t.Fatalf("Receive error: %v | must be: %v", err, mercadopago.ErrCurrencyMismatch)
+	}
+	if empty.Payments != 1 || empty.Fee(mercadopago.FeeTypeMercadoPago) != mercadopago.NewMoney(4.9) {
+		t.Fatalf("A payment of other currency must not be added, receive %+v", empty)
+	}
+}
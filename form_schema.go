@@ -0,0 +1,244 @@
+package mercadopago
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormSchema describe the inputs that a checkout form need to pay with a payment method,
+// so the frontend can render them without knowing the payment method.
+type FormSchema struct {
+	PaymentMethodID string      `json:"payment_method_id"`
+	PaymentTypeID   string      `json:"payment_type_id"`
+	Fields          []FormField `json:"fields"`
+}
+
+type FormField struct {
+	Name      string       `json:"name"`
+	Label     string       `json:"label"`
+	Type      string       `json:"type"`
+	Required  bool         `json:"required"`
+	Pattern   string       `json:"pattern,omitempty"`
+	MinLength int          `json:"min_length,omitempty"`
+	MaxLength int          `json:"max_length,omitempty"`
+	Options   []FormOption `json:"options,omitempty"`
+	// BinPatterns are the patterns of the first digits accepted by the payment method, only in the card number.
+	// The number belongs to the payment method when it match any Pattern without matching its ExclusionPattern.
+	BinPatterns []FormBinPattern `json:"bin_patterns,omitempty"`
+}
+
+// FormBinPattern is the Bin of the settings of a payment method, to validate the card number in the frontend.
+type FormBinPattern struct {
+	Pattern          string `json:"pattern"`
+	ExclusionPattern string `json:"exclusion_pattern,omitempty"`
+}
+
+type FormOption struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+const (
+	FormFieldText   = "text"
+	FormFieldNumber = "number"
+	FormFieldEmail  = "email"
+	FormFieldSelect = "select"
+)
+
+// FormSchema convert the settings and the additional info needed by the payment method into the fields of a form.
+// The identification types are used for the options and lengths of the identification fields, they can be nil.
+// The options of the issuer must be loaded with CardIssuers.
+func (m PaymentMethod) FormSchema(types IdentificationTypes) FormSchema {
+	schema := FormSchema{
+		PaymentMethodID: m.ID,
+		PaymentTypeID:   m.PaymentTypeID,
+	}
+
+	if len(m.Settings) > 0 {
+		schema.Fields = append(schema.Fields, m.cardFields()...)
+	}
+
+	for _, info := range m.AdditionalInfoNeeded {
+		schema.Fields = append(schema.Fields, m.infoField(info, types))
+	}
+
+	// Some methods have financial institutions without asking for them in the additional info
+	if len(m.FinancialInstitutions) > 0 && !m.RequiresInfo(InfoFinancialInstitution) {
+		schema.Fields = append(schema.Fields, m.infoField(InfoFinancialInstitution, types))
+	}
+
+	return schema
+}
+
+// Field return the field with the name.
+func (s FormSchema) Field(name string) (FormField, bool) {
+	for _, field := range s.Fields {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return FormField{}, false
+}
+
+func (m PaymentMethod) cardFields() []FormField {
+	// A payment method can have many settings with different lengths, like maestro with 18 and 19 digits
+	numberMin, numberMax := 0, 0
+	codeMin, codeMax := 0, 0
+	codeRequired := false
+	var binPatterns []FormBinPattern
+	for _, settings := range m.Settings {
+		if settings.Bin.Pattern != "" {
+			binPatterns = appendBinPattern(binPatterns, FormBinPattern{
+				Pattern:          settings.Bin.Pattern,
+				ExclusionPattern: settings.Bin.ExclusionPattern,
+			})
+		}
+		numberMin, numberMax = expand(numberMin, numberMax, settings.CardNumber.Length)
+		codeMin, codeMax = expand(codeMin, codeMax, settings.SecurityCode.Length)
+		if settings.SecurityCode.Mode == "mandatory" {
+			codeRequired = true
+		}
+	}
+
+	fields := []FormField{
+		{
+			Name:        "card_number",
+			Label:       "Card number",
+			Type:        FormFieldNumber,
+			Required:    true,
+			Pattern:     digitsPattern(numberMin, numberMax),
+			MinLength:   numberMin,
+			MaxLength:   numberMax,
+			BinPatterns: binPatterns,
+		},
+		{
+			Name:      "expiration_month",
+			Label:     "Expiration month",
+			Type:      FormFieldNumber,
+			Required:  true,
+			Pattern:   `^(0?[1-9]|1[0-2])$`,
+			MinLength: 1,
+			MaxLength: 2,
+		},
+		{
+			Name:      "expiration_year",
+			Label:     "Expiration year",
+			Type:      FormFieldNumber,
+			Required:  true,
+			Pattern:   `^(\d{2}|\d{4})$`,
+			MinLength: 2,
+			MaxLength: 4,
+		},
+	}
+
+	if codeMax > 0 {
+		fields = append(fields, FormField{
+			Name:      "security_code",
+			Label:     "Security code",
+			Type:      FormFieldNumber,
+			Required:  codeRequired,
+			Pattern:   digitsPattern(codeMin, codeMax),
+			MinLength: codeMin,
+			MaxLength: codeMax,
+		})
+	}
+
+	return fields
+}
+
+// appendBinPattern append the pattern if it's not already in the list, the settings of a payment method can
+// repeat the same bin with different lengths.
+func appendBinPattern(patterns []FormBinPattern, bin FormBinPattern) []FormBinPattern {
+	for _, p := range patterns {
+		if p == bin {
+			return patterns
+		}
+	}
+	return append(patterns, bin)
+}
+
+func (m PaymentMethod) infoField(info AdditionalInfo, types IdentificationTypes) FormField {
+	field := FormField{
+		Name:     string(info),
+		Label:    infoLabel(info),
+		Type:     FormFieldText,
+		Required: true,
+	}
+
+	switch info {
+	case InfoCardholderIdentificationType:
+		field.Type = FormFieldSelect
+		for _, t := range types {
+			field.Options = append(field.Options, FormOption{Value: t.ID, Label: t.Name})
+		}
+	case InfoCardholderIdentificationNumber:
+		for _, t := range types {
+			field.MinLength, field.MaxLength = expand(field.MinLength, field.MaxLength, t.MinLength)
+			field.MinLength, field.MaxLength = expand(field.MinLength, field.MaxLength, t.MaxLength)
+		}
+	case InfoIssuerID:
+		field.Type = FormFieldSelect
+	case InfoPayerEmail:
+		field.Type = FormFieldEmail
+	case InfoEntityType:
+		field.Type = FormFieldSelect
+		field.Options = []FormOption{
+			{Value: "individual", Label: "Individual"},
+			{Value: "association", Label: "Association"},
+		}
+	case InfoFinancialInstitution:
+		field.Type = FormFieldSelect
+		for _, fi := range m.FinancialInstitutions {
+			field.Options = append(field.Options, FormOption{Value: fi.ID, Label: fi.Description})
+		}
+	}
+
+	return field
+}
+
+var infoLabels = map[AdditionalInfo]string{
+	InfoCardholderName:                 "Cardholder name",
+	InfoCardholderIdentificationType:   "Identification type",
+	InfoCardholderIdentificationNumber: "Identification number",
+	InfoIssuerID:                       "Issuer",
+	InfoPayerEmail:                     "Email",
+	InfoEntityType:                     "Person type",
+	InfoFinancialInstitution:           "Bank",
+}
+
+// infoLabel return the label of the info, for the unknown infos it's generated from the name.
+func infoLabel(info AdditionalInfo) string {
+	if label, ok := infoLabels[info]; ok {
+		return label
+	}
+	label := strings.ReplaceAll(string(info), "_", " ")
+	if label == "" {
+		return label
+	}
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+// expand return the range with the value included, ignoring the zero values.
+func expand(min, max, value int) (int, int) {
+	if value <= 0 {
+		return min, max
+	}
+	if min == 0 || value < min {
+		min = value
+	}
+	if value > max {
+		max = value
+	}
+	return min, max
+}
+
+func digitsPattern(min, max int) string {
+	switch {
+	case max == 0:
+		return `^\d+$`
+	case min == max:
+		return fmt.Sprintf(`^\d{%d}$`, min)
+	default:
+		return fmt.Sprintf(`^\d{%d,%d}$`, min, max)
+	}
+}
@@ -0,0 +1,105 @@
+package mercadopago_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestFormSchema(t *testing.T) {
+
+	paymentMethods := loadPaymentMethods(t)
+	types := mercadopago.IdentificationTypes{
+		{ID: "DNI", Name: "DNI", Type: "number", MinLength: 7, MaxLength: 8},
+		{ID: "CUIT", Name: "CUIT", Type: "number", MinLength: 11, MaxLength: 11},
+	}
+
+	master, _ := paymentMethods.ByID("master")
+	schema := master.FormSchema(types)
+
+	tests := []struct {
+		name     string
+		expected mercadopago.FormField
+	}{
+		{
+			name: "card_number",
+			expected: mercadopago.FormField{
+				Name: "card_number", Label: "Card number", Type: mercadopago.FormFieldNumber, Required: true,
+				Pattern: `^\d{16}$`, MinLength: 16, MaxLength: 16,
+				BinPatterns: []mercadopago.FormBinPattern{
+					{Pattern: master.Settings[0].Bin.Pattern, ExclusionPattern: master.Settings[0].Bin.ExclusionPattern},
+				},
+			},
+		},
+		{
+			name: "security_code",
+			expected: mercadopago.FormField{
+				Name: "security_code", Label: "Security code", Type: mercadopago.FormFieldNumber, Required: true,
+				Pattern: `^\d{3}$`, MinLength: 3, MaxLength: 3,
+			},
+		},
+		{
+			name: "cardholder_identification_number",
+			expected: mercadopago.FormField{
+				Name: "cardholder_identification_number", Label: "Identification number", Type: mercadopago.FormFieldText,
+				Required: true, MinLength: 7, MaxLength: 11,
+			},
+		},
+		{
+			name: "issuer_id",
+			expected: mercadopago.FormField{
+				Name: "issuer_id", Label: "Issuer", Type: mercadopago.FormFieldSelect, Required: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := schema.Field(tt.name)
+			if !ok {
+				t.Fatalf("Expected field %s in schema", tt.name)
+			}
+			gotJSON, _ := json.Marshal(got)
+			expectedJSON, _ := json.Marshal(tt.expected)
+			if string(gotJSON) != string(expectedJSON) {
+				t.Fatalf("Expected field %s but receive %s", expectedJSON, gotJSON)
+			}
+		})
+	}
+
+	idType, _ := schema.Field("cardholder_identification_type")
+	if len(idType.Options) != 2 || idType.Options[1].Value != "CUIT" {
+		t.Fatalf("Expected identification type options but receive %v", idType.Options)
+	}
+
+	// Maestro has settings with 18 and 19 digits
+	maestro, _ := paymentMethods.ByID("maestro")
+	number, _ := maestro.FormSchema(nil).Field("card_number")
+	if number.Pattern != `^\d{18,19}$` {
+		t.Fatalf("Unexpected card number pattern: %s", number.Pattern)
+	}
+	if len(number.BinPatterns) != 2 || number.BinPatterns[1].Pattern != "^(501068|601782|508143|501081|501080)" {
+		t.Fatalf("Expected the bin patterns of both settings but receive %v", number.BinPatterns)
+	}
+
+	// Tickets don't have card fields
+	if fields := paymentMethods[len(paymentMethods)-1].FormSchema(nil).Fields; len(fields) != 0 {
+		t.Fatalf("Ticket should not have fields but receive %v", fields)
+	}
+
+	pse := mercadopago.PaymentMethod{
+		ID:                   "pse",
+		PaymentTypeID:        mercadopago.PaymentTypeBankTransfer,
+		AdditionalInfoNeeded: []mercadopago.AdditionalInfo{mercadopago.InfoEntityType},
+		FinancialInstitutions: []mercadopago.FinancialInstitution{
+			{ID: "1040", Description: "Banco Agrario"},
+			{ID: "1007", Description: "Bancolombia"},
+		},
+	}
+	bank, ok := pse.FormSchema(nil).Field("financial_institution")
+	if !ok || bank.Type != mercadopago.FormFieldSelect || len(bank.Options) != 2 || bank.Options[1].Label != "Bancolombia" {
+		t.Fatalf("Expected the financial institutions as options but receive %v", bank)
+	}
+}
@@ -2,4 +2,7 @@ module github.com/jackgris/mercadopago
 
 go 1.20
 
-require github.com/joho/godotenv v1.5.1
+require (
+	github.com/joho/godotenv v1.5.1
+	golang.org/x/sync v0.10.0
+)
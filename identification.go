@@ -0,0 +1,159 @@
+package mercadopago
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/jackgris/mercadopago/nationalid"
+)
+
+// Identification is the identification document of a cardholder or a payer.
+type Identification struct {
+	Type   string `json:"type,omitempty"`
+	Number string `json:"number,omitempty"`
+}
+
+type Cardholder struct {
+	Name           string         `json:"name,omitempty"`
+	Identification Identification `json:"identification"`
+}
+
+// siteIdentificationTypes are the identification types accepted in every site.
+var siteIdentificationTypes = map[string][]string{
+	"MLA": {"DNI", "CI", "LC", "LE", "CUIT", "CUIL", "Otro"},
+	"MLB": {"CPF", "CNPJ"},
+	"MLC": {"RUT", "Otro"},
+	"MCO": {"CC", "CE", "NIT", "Otro"},
+	"MLM": {"RFC", "CURP"},
+	"MPE": {"DNI", "C.E", "RUC", "Otro"},
+	"MLU": {"CI", "Otro"},
+}
+
+// optionalIdentificationSites are the sites where the identification can be missing, Mexico doesn't ask for it,
+// but when it's informed it must be valid.
+var optionalIdentificationSites = map[string]bool{
+	"MLM": true,
+}
+
+// ValidateIdentification check that the identification type is accepted in the site and it has a number.
+func ValidateIdentification(siteID string, id Identification) error {
+	return validateIdentification(siteID, id, "identification")
+}
+
+// ValidateCardholder check the cardholder identification of the request against the types accepted in the site.
+func (r RequestCardToken) ValidateCardholder(siteID string) error {
+	return validateIdentification(siteID, r.Cardholder.Identification, "cardholder.identification")
+}
+
+func validateIdentification(siteID string, id Identification, field string) error {
+	types, ok := siteIdentificationTypes[siteID]
+	if !ok {
+		return fmt.Errorf("unknown site ID: %q", siteID)
+	}
+
+	var errs ValidationErrors
+	if id.Type == "" && id.Number == "" && optionalIdentificationSites[siteID] {
+		return nil
+	}
+
+	accepted := false
+	for _, t := range types {
+		if t == id.Type {
+			accepted = true
+			break
+		}
+	}
+	if !accepted {
+		errs = append(errs, ValidationError{
+			Field:   field + ".type",
+			Message: fmt.Sprintf("%q is not accepted in site %s", id.Type, siteID),
+		})
+	}
+	if id.Number == "" {
+		errs = append(errs, ValidationError{Field: field + ".number", Message: "is required"})
+	} else if err := nationalid.Validate(siteID, id.Type, id.Number); err != nil {
+		errs = append(errs, ValidationError{Field: field + ".number", Message: err.Error()})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+type IdentificationTypes []IdentificationType
+
+type IdentificationType struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	MinLength int    `json:"min_length"`
+	MaxLength int    `json:"max_length"`
+}
+
+// Validate check the length and the characters of the document number.
+func (t IdentificationType) Validate(number string) error {
+	if len(number) < t.MinLength || (t.MaxLength > 0 && len(number) > t.MaxLength) {
+		return fmt.Errorf("%s must have between %d and %d characters", t.ID, t.MinLength, t.MaxLength)
+	}
+	if t.Type == "number" && !isDigits(number) {
+		return fmt.Errorf("%s must contain only digits", t.ID)
+	}
+	return nil
+}
+
+// Validate check the identification against the types returned by the API for the site of the token,
+// and against the offline validator of the document when the site is not empty.
+func (types IdentificationTypes) Validate(siteID string, id Identification) error {
+	for _, t := range types {
+		if t.ID != id.Type {
+			continue
+		}
+		if err := t.Validate(id.Number); err != nil {
+			return ValidationErrors{{Field: "identification.number", Message: err.Error()}}
+		}
+		if err := nationalid.Validate(siteID, id.Type, id.Number); err != nil {
+			return ValidationErrors{{Field: "identification.number", Message: err.Error()}}
+		}
+		return nil
+	}
+
+	return ValidationErrors{{Field: "identification.type", Message: fmt.Sprintf("%q is not accepted", id.Type)}}
+}
+
+// IdentificationTypes return the identification types accepted in the site of the access token.
+func (c *Client) IdentificationTypes(ctx context.Context) (IdentificationTypes, error) {
+
+	url := fmt.Sprintf("%sv1/identification_types", c.BaseURL)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return nil, &errRes
+		}
+
+		return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	var identificationTypes IdentificationTypes
+	if err := json.NewDecoder(res.Body).Decode(&identificationTypes); err != nil {
+		return nil, errors.New("Can't parse response")
+	}
+
+	return identificationTypes, nil
+}
@@ -0,0 +1,184 @@
+package mercadopago_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestValidateCardholder(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		siteID         string
+		identification mercadopago.Identification
+		expectedFields []string
+		expectedErr    bool
+	}{
+		{
+			name:           "DNI in Argentina",
+			siteID:         "MLA",
+			identification: mercadopago.Identification{Type: "DNI", Number: "12345678"},
+		},
+		{
+			name:           "CPF in Brazil",
+			siteID:         "MLB",
+			identification: mercadopago.Identification{Type: "CPF", Number: "19119119100"},
+		},
+		{
+			name:           "DNI in Brazil",
+			siteID:         "MLB",
+			identification: mercadopago.Identification{Type: "DNI", Number: "12345678"},
+			expectedFields: []string{"cardholder.identification.type"},
+		},
+		{
+			name:           "Invalid CPF check digit",
+			siteID:         "MLB",
+			identification: mercadopago.Identification{Type: "CPF", Number: "19119119101"},
+			expectedFields: []string{"cardholder.identification.number"},
+		},
+		{
+			name:           "Without number",
+			siteID:         "MLA",
+			identification: mercadopago.Identification{Type: "DNI"},
+			expectedFields: []string{"cardholder.identification.number"},
+		},
+		{
+			name:           "Mexico without identification",
+			siteID:         "MLM",
+			identification: mercadopago.Identification{},
+		},
+		{
+			name:           "RFC in Mexico",
+			siteID:         "MLM",
+			identification: mercadopago.Identification{Type: "RFC", Number: "GODE561231GR8"},
+		},
+		{
+			name:           "CURP in Mexico",
+			siteID:         "MLM",
+			identification: mercadopago.Identification{Type: "CURP", Number: "HEGG560427MVZRRL04"},
+		},
+		{
+			name:           "Invalid RFC check digit",
+			siteID:         "MLM",
+			identification: mercadopago.Identification{Type: "RFC", Number: "GODE561231GR9"},
+			expectedFields: []string{"cardholder.identification.number"},
+		},
+		{
+			name:           "DNI in Mexico",
+			siteID:         "MLM",
+			identification: mercadopago.Identification{Type: "DNI", Number: "12345678"},
+			expectedFields: []string{"cardholder.identification.type"},
+		},
+		{
+			name:           "Unknown site",
+			siteID:         "SLA",
+			identification: mercadopago.Identification{Type: "DNI", Number: "12345678"},
+			expectedErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+			card := mercadopago.RequestCardToken{
+				Cardholder: mercadopago.Cardholder{Name: "APRO", Identification: tt.identification},
+			}
+			err := card.ValidateCardholder(tt.siteID)
+			if tt.expectedErr {
+				if err == nil {
+					t.Fatal("Expected an error but receive nil")
+				}
+				return
+			}
+			if len(tt.expectedFields) == 0 {
+				if err != nil {
+					t.Fatalf("Identification should be valid but receive: %s", err)
+				}
+				return
+			}
+
+			var errs mercadopago.ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("Expected validation errors but receive: %v", err)
+			}
+			for _, field := range tt.expectedFields {
+				if errs.Field(field) == nil {
+					t.Fatalf("Expected error in field %s but receive: %s", field, errs)
+				}
+			}
+		})
+	}
+}
+
+func TestIdentificationTypes(t *testing.T) {
+
+	var rightResponse string = `
+        [
+          {"id": "DNI", "name": "DNI", "type": "number", "min_length": 7, "max_length": 8},
+          {"id": "CUIT", "name": "CUIT", "type": "number", "min_length": 11, "max_length": 11},
+          {"id": "Otro", "name": "Otro", "type": "number", "min_length": 5, "max_length": 20}
+        ]
+        `
+	var response mercadopago.IdentificationTypes
+	if err := json.NewDecoder(strings.NewReader(rightResponse)).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if http.MethodGet != r.Method {
+			t.Fatalf("HTTP Method expected is %s but receive %s", http.MethodGet, r.Method)
+		}
+		urlPAth := "/v1/identification_types"
+		if urlPAth != r.URL.Path {
+			t.Fatalf("Path URL expected is %s but receive %s", urlPAth, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(rightResponse))
+	}))
+	defer server.Close()
+
+	client := mercadopago.NewClient(server.URL+"/", accessToken)
+	got, err := client.IdentificationTypes(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, response) {
+		t.Fatalf("Expected result is %v but receive %v", response, got)
+	}
+
+	tests := []struct {
+		name           string
+		identification mercadopago.Identification
+		valid          bool
+	}{
+		{name: "Valid DNI", identification: mercadopago.Identification{Type: "DNI", Number: "12345678"}, valid: true},
+		{name: "Short DNI", identification: mercadopago.Identification{Type: "DNI", Number: "12345"}},
+		{name: "Valid CUIT", identification: mercadopago.Identification{Type: "CUIT", Number: "20123456786"}, valid: true},
+		{name: "Invalid CUIT check digit", identification: mercadopago.Identification{Type: "CUIT", Number: "20123456785"}},
+		{name: "Not accepted type", identification: mercadopago.Identification{Type: "CPF", Number: "19119119100"}},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := got.Validate("MLA", tt.identification)
+			if tt.valid && err != nil {
+				t.Fatalf("Identification should be valid but receive: %s", err)
+			}
+			if !tt.valid && err == nil {
+				t.Fatal("Identification should be invalid")
+			}
+		})
+	}
+}
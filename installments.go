@@ -0,0 +1,214 @@
+package mercadopago
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RequestInstallments are the parameters to search the installments, it needs the amount and the bin or the payment method ID.
+type RequestInstallments struct {
+	Amount          float64
+	Bin             string
+	PaymentMethodID string
+	IssuerID        string
+	PaymentTypeID   string
+	ProcessingMode  string
+}
+
+// Validate check that the request has the amount and the bin or the payment method.
+func (r RequestInstallments) Validate() error {
+	var errs ValidationErrors
+	if r.Amount <= 0 {
+		errs = append(errs, ValidationError{Field: "amount", Message: "must be greater than zero"})
+	}
+	if r.Bin == "" && r.PaymentMethodID == "" {
+		errs = append(errs, ValidationError{Field: "bin", Message: "bin or payment method ID is required"})
+	} else if r.Bin != "" && !isDigits(r.Bin) {
+		errs = append(errs, ValidationError{Field: "bin", Message: "must contain only digits"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (r RequestInstallments) query() url.Values {
+	q := url.Values{}
+	q.Set("amount", strconv.FormatFloat(r.Amount, 'f', -1, 64))
+	if r.Bin != "" {
+		q.Set("bin", r.Bin)
+	}
+	if r.PaymentMethodID != "" {
+		q.Set("payment_method_id", r.PaymentMethodID)
+	}
+	if r.IssuerID != "" {
+		q.Set("issuer.id", r.IssuerID)
+	}
+	if r.PaymentTypeID != "" {
+		q.Set("payment_type_id", r.PaymentTypeID)
+	}
+	if r.ProcessingMode != "" {
+		q.Set("processing_mode", r.ProcessingMode)
+	}
+	return q
+}
+
+type Installments struct {
+	PaymentMethodID   string      `json:"payment_method_id"`
+	PaymentTypeID     string      `json:"payment_type_id"`
+	Thumbnail         string      `json:"thumbnail"`
+	SecureThumbnail   string      `json:"secure_thumbnail"`
+	ProcessingMode    string      `json:"processing_mode"`
+	MerchantAccountID string      `json:"merchant_account_id"`
+	Issuer            Issuer      `json:"issuer"`
+	PayerCosts        []PayerCost `json:"payer_costs"`
+}
+
+type PayerCost struct {
+	Installments          int      `json:"installments"`
+	InstallmentRate       float64  `json:"installment_rate"`
+	DiscountRate          float64  `json:"discount_rate"`
+	ReimbursementRate     float64  `json:"reimbursement_rate"`
+	Labels                []string `json:"labels"`
+	MinAllowedAmount      float64  `json:"min_allowed_amount"`
+	MaxAllowedAmount      float64  `json:"max_allowed_amount"`
+	RecommendedMessage    string   `json:"recommended_message"`
+	InstallmentAmount     float64  `json:"installment_amount"`
+	TotalAmount           float64  `json:"total_amount"`
+	PaymentMethodOptionID string   `json:"payment_method_option_id"`
+}
+
+// Installments return the installments available for the amount and the card, with the costs for the payer.
+func (c *Client) Installments(ctx context.Context, data RequestInstallments) ([]Installments, error) {
+
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%sv1/payment_methods/installments?%s", c.BaseURL, data.query().Encode())
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return nil, &errRes
+		}
+
+		return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	var installments []Installments
+	if err := json.NewDecoder(res.Body).Decode(&installments); err != nil {
+		return nil, errors.New("Can't parse response")
+	}
+
+	return installments, nil
+}
+
+// PayerCost return the payer cost with the number of installments.
+func (i Installments) PayerCost(installments int) (PayerCost, bool) {
+	for _, cost := range i.PayerCosts {
+		if cost.Installments == installments {
+			return cost, true
+		}
+	}
+	return PayerCost{}, false
+}
+
+// CFT return the total financial cost (Costo Financiero Total) informed in the labels, as a percentage.
+// The API only inform it in the sites where it's mandatory, like Argentina.
+func (p PayerCost) CFT() (float64, bool) {
+	return p.labelRate("CFT")
+}
+
+// TEA return the effective annual rate (Tasa Efectiva Anual) informed in the labels, as a percentage.
+func (p PayerCost) TEA() (float64, bool) {
+	return p.labelRate("TEA")
+}
+
+// labelRate parse the labels with the rates, they look like: CFT_45,51%|TEA_36,37%
+func (p PayerCost) labelRate(name string) (float64, bool) {
+	for _, label := range p.Labels {
+		for _, part := range strings.Split(label, "|") {
+			value, ok := strings.CutPrefix(part, name+"_")
+			if !ok {
+				continue
+			}
+			value = strings.TrimSuffix(value, "%")
+			value = strings.ReplaceAll(strings.ReplaceAll(value, ".", ""), ",", ".")
+			rate, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return 0, false
+			}
+			return rate, true
+		}
+	}
+	return 0, false
+}
+
+// EffectiveAnnualRate compute the effective annual rate as a percentage, from the amount financed and the
+// amount of each monthly installment. It's useful in the sites where the API doesn't inform the TEA.
+func (p PayerCost) EffectiveAnnualRate(amount float64) float64 {
+	monthly := monthlyRate(amount, p.InstallmentAmount, p.Installments)
+	return (math.Pow(1+monthly, 12) - 1) * 100
+}
+
+// RatesLabel return the rates to display with the payer cost, like: CFT: 45,51% - TEA: 36,37%
+// When the API doesn't inform the TEA it's computed from the amount. The CFT includes taxes and costs that
+// can't be computed, so when the API doesn't inform it the label only has the TEA.
+func (p PayerCost) RatesLabel(amount float64) string {
+	tea, ok := p.TEA()
+	if !ok {
+		tea = p.EffectiveAnnualRate(amount)
+	}
+	cft, ok := p.CFT()
+	if !ok {
+		return fmt.Sprintf("TEA: %s%%", formatRate(tea))
+	}
+	return fmt.Sprintf("CFT: %s%% - TEA: %s%%", formatRate(cft), formatRate(tea))
+}
+
+func formatRate(rate float64) string {
+	return strings.Replace(strconv.FormatFloat(rate, 'f', 2, 64), ".", ",", 1)
+}
+
+// monthlyRate find the interest rate of a loan paid with fixed installments (French amortization), by bisection.
+func monthlyRate(amount, installment float64, n int) float64 {
+	if n <= 0 || amount <= 0 || installment*float64(n) <= amount {
+		return 0
+	}
+
+	// installment for a rate, it grows with the rate
+	payment := func(rate float64) float64 {
+		return amount * rate / (1 - math.Pow(1+rate, -float64(n)))
+	}
+
+	low, high := 0.0, 1.0
+	for i := 0; i < 100; i++ {
+		mid := (low + high) / 2
+		if payment(mid) < installment {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return (low + high) / 2
+}
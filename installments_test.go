@@ -0,0 +1,193 @@
+package mercadopago_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestInstallments(t *testing.T) {
+
+	var rightResponse string = `
+        [
+          {
+            "payment_method_id": "visa",
+            "payment_type_id": "credit_card",
+            "thumbnail": "https://http2.mlstatic.com/storage/logos-api-admin/visa.gif",
+            "secure_thumbnail": "https://http2.mlstatic.com/storage/logos-api-admin/visa.gif",
+            "processing_mode": "aggregator",
+            "merchant_account_id": null,
+            "issuer": {
+              "id": "310",
+              "name": "Visa Argentina S.A.",
+              "secure_thumbnail": "https://www.mercadopago.com/org-img/MP3/API/logos/visa.gif",
+              "thumbnail": "http://img.mlstatic.com/org-img/MP3/API/logos/visa.gif"
+            },
+            "payer_costs": [
+              {
+                "installments": 1,
+                "installment_rate": 0,
+                "discount_rate": 0,
+                "reimbursement_rate": null,
+                "labels": ["CFT_0,00%|TEA_0,00%"],
+                "min_allowed_amount": 3,
+                "max_allowed_amount": 2500000,
+                "recommended_message": "1 cuota de $ 1.000,00 ($ 1.000,00)",
+                "installment_amount": 1000,
+                "total_amount": 1000,
+                "payment_method_option_id": "1.AQokODllZjQyOGMtZjk4MC00"
+              },
+              {
+                "installments": 3,
+                "installment_rate": 20,
+                "discount_rate": 0,
+                "reimbursement_rate": null,
+                "labels": ["recommended_installment", "CFT_150,47%|TEA_118,26%"],
+                "min_allowed_amount": 3,
+                "max_allowed_amount": 2500000,
+                "recommended_message": "3 cuotas de $ 400,00 ($ 1.200,00)",
+                "installment_amount": 400,
+                "total_amount": 1200,
+                "payment_method_option_id": "1.AQokODllZjQyOGMtZjk4MC01"
+              }
+            ]
+          }
+        ]
+        `
+	var response []mercadopago.Installments
+	if err := json.NewDecoder(strings.NewReader(rightResponse)).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+
+	tests := []struct {
+		name             string
+		request          mercadopago.RequestInstallments
+		expectedQuery    string
+		expectedResponse []mercadopago.Installments
+		expectedErr      bool
+	}{
+		{
+			name:             "With bin",
+			request:          mercadopago.RequestInstallments{Amount: 1000, Bin: "450995"},
+			expectedQuery:    "amount=1000&bin=450995",
+			expectedResponse: response,
+		},
+		{
+			name:             "With payment method and issuer",
+			request:          mercadopago.RequestInstallments{Amount: 1000.5, PaymentMethodID: "visa", IssuerID: "310"},
+			expectedQuery:    "amount=1000.5&issuer.id=310&payment_method_id=visa",
+			expectedResponse: response,
+		},
+		{
+			name:        "Without bin and payment method",
+			request:     mercadopago.RequestInstallments{Amount: 1000},
+			expectedErr: true,
+		},
+		{
+			name:        "Without amount",
+			request:     mercadopago.RequestInstallments{Bin: "450995"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if http.MethodGet != r.Method {
+					t.Fatalf("HTTP Method expected is %s but receive %s", http.MethodGet, r.Method)
+				}
+				urlPAth := "/v1/payment_methods/installments"
+				if urlPAth != r.URL.Path {
+					t.Fatalf("Path URL expected is %s but receive %s", urlPAth, r.URL.Path)
+				}
+				if tt.expectedQuery != r.URL.RawQuery {
+					t.Fatalf("Query expected is %s but receive %s", tt.expectedQuery, r.URL.RawQuery)
+				}
+
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(rightResponse))
+			}))
+			defer server.Close()
+
+			client := mercadopago.NewClient(server.URL+"/", accessToken)
+			got, err := client.Installments(context.Background(), tt.request)
+			if tt.expectedErr {
+				var errs mercadopago.ValidationErrors
+				if !errors.As(err, &errs) {
+					t.Fatalf("Expected validation errors but receive: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.expectedResponse) {
+				t.Fatalf("Expected result is %v but receive %v", tt.expectedResponse, got)
+			}
+		})
+	}
+
+	cost, ok := response[0].PayerCost(3)
+	if !ok {
+		t.Fatal("Expected payer cost with 3 installments")
+	}
+	if cft, ok := cost.CFT(); !ok || cft != 150.47 {
+		t.Fatalf("Expected CFT 150.47 but receive %v", cft)
+	}
+	if tea, ok := cost.TEA(); !ok || tea != 118.26 {
+		t.Fatalf("Expected TEA 118.26 but receive %v", tea)
+	}
+	if label := cost.RatesLabel(1000); label != "CFT: 150,47% - TEA: 118,26%" {
+		t.Fatalf("Unexpected rates label: %s", label)
+	}
+}
+
+func TestPayerCostEffectiveAnnualRate(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		cost     mercadopago.PayerCost
+		amount   float64
+		expected float64
+	}{
+		{
+			name:     "Without interest",
+			cost:     mercadopago.PayerCost{Installments: 6, InstallmentAmount: 100},
+			amount:   600,
+			expected: 0,
+		},
+		{
+			name:     "Twelve installments",
+			cost:     mercadopago.PayerCost{Installments: 12, InstallmentAmount: 100},
+			amount:   1000,
+			expected: 41.30,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.cost.EffectiveAnnualRate(tt.amount)
+			if math.Abs(got-tt.expected) > 0.05 {
+				t.Fatalf("Expected rate %.2f but receive %.2f", tt.expected, got)
+			}
+		})
+	}
+
+	cost := mercadopago.PayerCost{Installments: 12, InstallmentAmount: 100}
+	if label := cost.RatesLabel(1000); label != "TEA: 41,30%" {
+		t.Fatalf("Unexpected rates label: %s", label)
+	}
+}
@@ -0,0 +1,89 @@
+package mercadopago
+
+import "context"
+
+// Paging is the pagination information of the search endpoints.
+type Paging struct {
+	Total  int `json:"total"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// pageFetcher return the page of results that start at the offset.
+type pageFetcher[T any] func(ctx context.Context, offset, limit int) ([]T, Paging, error)
+
+// Iterator walk the results of a search endpoint, fetching the pages when they are needed.
+//
+//	it := client.SearchPaymentMethodsIter(search)
+//	for it.Next(ctx) {
+//		method := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	fetch  pageFetcher[T]
+	limit  int
+	offset int
+	page   []T
+	index  int
+	total  int
+	done   bool
+	err    error
+}
+
+func newIterator[T any](fetch pageFetcher[T], offset, limit int) *Iterator[T] {
+	return &Iterator[T]{
+		fetch:  fetch,
+		offset: offset,
+		limit:  limit,
+		index:  -1,
+		total:  -1,
+	}
+}
+
+// Next advance to the next result, it return false when there are no more results or after an error.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+	if it.index < len(it.page) {
+		return true
+	}
+	if it.done {
+		return false
+	}
+
+	page, paging, err := it.fetch(ctx, it.offset, it.limit)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.page = page
+	it.index = 0
+	it.total = paging.Total
+	it.offset += len(page)
+	if len(page) == 0 || it.offset >= paging.Total {
+		it.done = true
+	}
+
+	return len(page) > 0
+}
+
+// Value return the current result.
+func (it *Iterator[T]) Value() T {
+	return it.page[it.index]
+}
+
+// Total return the total of results informed by the API, or -1 before fetching the first page.
+func (it *Iterator[T]) Total() int {
+	return it.total
+}
+
+// Err return the error that stopped the iteration.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
@@ -0,0 +1,38 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// Money is an amount in cents, so the amounts can be added and subtracted without rounding errors.
+// It's marshaled to JSON as the decimal number used by the API.
+type Money int64
+
+// NewMoney convert an amount of the API to Money, rounding it to cents.
+func NewMoney(amount float64) Money {
+	return Money(math.Round(amount * 100))
+}
+
+// Float64 return the amount as the decimal number used by the API.
+func (m Money) Float64() float64 {
+	return float64(m) / 100
+}
+
+func (m Money) String() string {
+	return strconv.FormatFloat(m.Float64(), 'f', 2, 64)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatFloat(m.Float64(), 'f', -1, 64)), nil
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var amount float64
+	if err := json.Unmarshal(data, &amount); err != nil {
+		return err
+	}
+	*m = NewMoney(amount)
+	return nil
+}
@@ -0,0 +1,316 @@
+// Package nationalid validate offline the identification documents used by Mercado Pago in every site,
+// so the invalid documents can be rejected before calling the API.
+package nationalid
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidFormat is returned when the document doesn't have the right length or characters.
+	ErrInvalidFormat = errors.New("invalid document format")
+	// ErrInvalidCheckDigit is returned when the check digit of the document is wrong.
+	ErrInvalidCheckDigit = errors.New("invalid document check digit")
+)
+
+// Validate check the document number with the validator of the site and identification type.
+// The types without a known validator, like the passports, are always valid.
+func Validate(siteID, idType, number string) error {
+	validator, ok := validators[siteID+":"+strings.ToUpper(idType)]
+	if !ok {
+		return nil
+	}
+	return validator(number)
+}
+
+// HasValidator report if there is an offline validator for the site and identification type.
+func HasValidator(siteID, idType string) bool {
+	_, ok := validators[siteID+":"+strings.ToUpper(idType)]
+	return ok
+}
+
+var validators = map[string]func(string) error{
+	"MLB:CPF":  CPF,
+	"MLB:CNPJ": CNPJ,
+	"MLA:CUIT": CUIT,
+	"MLA:CUIL": CUIT,
+	"MLC:RUT":  RUT,
+	"MLM:RFC":  RFC,
+	"MLM:CURP": CURP,
+	"MLU:CI":   CIUruguay,
+	"MCO:CC":   CCColombia,
+	"MPE:DNI":  DNIPeru,
+}
+
+// CPF validate the Brazilian individual taxpayer number, with or without punctuation.
+func CPF(number string) error {
+	digits, err := onlyDigits(number, ".-")
+	if err != nil || len(digits) != 11 || allEqual(digits) {
+		return ErrInvalidFormat
+	}
+
+	for n := 9; n <= 10; n++ {
+		sum := 0
+		for i := 0; i < n; i++ {
+			sum += digits[i] * (n + 1 - i)
+		}
+		dv := sum * 10 % 11
+		if dv == 10 {
+			dv = 0
+		}
+		if dv != digits[n] {
+			return ErrInvalidCheckDigit
+		}
+	}
+
+	return nil
+}
+
+// CNPJ validate the Brazilian company taxpayer number, with or without punctuation.
+func CNPJ(number string) error {
+	digits, err := onlyDigits(number, "./-")
+	if err != nil || len(digits) != 14 || allEqual(digits) {
+		return ErrInvalidFormat
+	}
+
+	weights := []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	for n := 12; n <= 13; n++ {
+		sum := 0
+		for i := 0; i < n; i++ {
+			sum += digits[i] * weights[i+13-n]
+		}
+		dv := 11 - sum%11
+		if dv >= 10 {
+			dv = 0
+		}
+		if dv != digits[n] {
+			return ErrInvalidCheckDigit
+		}
+	}
+
+	return nil
+}
+
+// CUIT validate the Argentine tax identification numbers CUIT and CUIL, with or without dashes.
+func CUIT(number string) error {
+	digits, err := onlyDigits(number, "-")
+	if err != nil || len(digits) != 11 {
+		return ErrInvalidFormat
+	}
+
+	switch digits[0]*10 + digits[1] {
+	case 20, 23, 24, 27, 30, 33, 34:
+	default:
+		return ErrInvalidFormat
+	}
+
+	weights := []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+	sum := 0
+	for i, w := range weights {
+		sum += digits[i] * w
+	}
+	dv := 11 - sum%11
+	if dv == 11 {
+		dv = 0
+	}
+	if dv != digits[10] {
+		return ErrInvalidCheckDigit
+	}
+
+	return nil
+}
+
+// RUT validate the Chilean tax number, like 12.345.678-5, the check digit can be K.
+func RUT(number string) error {
+	number = strings.ToUpper(strings.NewReplacer(".", "", "-", "", " ", "").Replace(number))
+	if len(number) < 2 || len(number) > 9 {
+		return ErrInvalidFormat
+	}
+
+	body, dv := number[:len(number)-1], number[len(number)-1]
+	digits, err := onlyDigits(body, "")
+	if err != nil {
+		return ErrInvalidFormat
+	}
+
+	sum, factor := 0, 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += digits[i] * factor
+		factor++
+		if factor > 7 {
+			factor = 2
+		}
+	}
+
+	var expected byte
+	switch r := 11 - sum%11; r {
+	case 11:
+		expected = '0'
+	case 10:
+		expected = 'K'
+	default:
+		expected = byte('0' + r)
+	}
+	if dv != expected {
+		return ErrInvalidCheckDigit
+	}
+
+	return nil
+}
+
+var rfcPattern = regexp.MustCompile(`^([A-ZÑ&]{3,4})(\d{6})([A-Z\d]{2})([A\d])$`)
+
+// Generic RFC used for the general public and for foreigners, they don't have a valid check digit.
+var genericRFC = map[string]bool{"XAXX010101000": true, "XEXX010101000": true}
+
+// RFC validate the Mexican tax number of individuals (13 characters) and companies (12 characters).
+func RFC(number string) error {
+	number = strings.ToUpper(strings.TrimSpace(number))
+	if genericRFC[number] {
+		return nil
+	}
+
+	m := rfcPattern.FindStringSubmatch(number)
+	if m == nil || !validDate(m[2]) {
+		return ErrInvalidFormat
+	}
+
+	const dictionary = "0123456789ABCDEFGHIJKLMN&OPQRSTUVWXYZ Ñ"
+	chars := []rune(number)
+	if len(chars) == 12 {
+		chars = append([]rune{' '}, chars...)
+	}
+
+	sum := 0
+	for i, c := range chars[:12] {
+		sum += runeIndex(dictionary, c) * (13 - i)
+	}
+
+	var expected rune
+	switch dv := 11 - sum%11; dv {
+	case 11:
+		expected = '0'
+	case 10:
+		expected = 'A'
+	default:
+		expected = rune('0' + dv)
+	}
+	if chars[12] != expected {
+		return ErrInvalidCheckDigit
+	}
+
+	return nil
+}
+
+var curpPattern = regexp.MustCompile(`^[A-Z][AEIOUX][A-Z]{2}(\d{6})[HMX](AS|BC|BS|CC|CL|CM|CS|CH|DF|DG|GT|GR|HG|JC|MC|MN|MS|NT|NL|OC|PL|QT|QR|SP|SL|SR|TC|TS|TL|VZ|YN|ZS|NE)[B-DF-HJ-NP-TV-Z]{3}[A-Z\d]\d$`)
+
+// CURP validate the Mexican population registry key.
+func CURP(number string) error {
+	number = strings.ToUpper(strings.TrimSpace(number))
+	m := curpPattern.FindStringSubmatch(number)
+	if m == nil || !validDate(m[1]) {
+		return ErrInvalidFormat
+	}
+
+	const dictionary = "0123456789ABCDEFGHIJKLMNÑOPQRSTUVWXYZ"
+	sum := 0
+	for i, c := range number[:17] {
+		sum += runeIndex(dictionary, c) * (18 - i)
+	}
+	dv := (10 - sum%10) % 10
+	if int(number[17]-'0') != dv {
+		return ErrInvalidCheckDigit
+	}
+
+	return nil
+}
+
+// CIUruguay validate the Uruguayan identity card, like 1.234.567-2.
+func CIUruguay(number string) error {
+	digits, err := onlyDigits(number, ".-")
+	if err != nil || len(digits) < 7 || len(digits) > 8 {
+		return ErrInvalidFormat
+	}
+
+	body, dv := digits[:len(digits)-1], digits[len(digits)-1]
+	for len(body) < 7 {
+		body = append([]int{0}, body...)
+	}
+
+	weights := []int{2, 9, 8, 7, 6, 3, 4}
+	sum := 0
+	for i, w := range weights {
+		sum += body[i] * w
+	}
+	if (10-sum%10)%10 != dv {
+		return ErrInvalidCheckDigit
+	}
+
+	return nil
+}
+
+// CCColombia validate the Colombian citizenship card, it doesn't have a check digit.
+func CCColombia(number string) error {
+	digits, err := onlyDigits(number, ".")
+	if err != nil || len(digits) < 3 || len(digits) > 10 {
+		return ErrInvalidFormat
+	}
+	return nil
+}
+
+// DNIPeru validate the Peruvian national identity document, it has 8 digits.
+func DNIPeru(number string) error {
+	digits, err := onlyDigits(number, "")
+	if err != nil || len(digits) != 8 {
+		return ErrInvalidFormat
+	}
+	return nil
+}
+
+// onlyDigits return the digits of the number ignoring the separators, and an error with any other character.
+func onlyDigits(number, separators string) ([]int, error) {
+	digits := make([]int, 0, len(number))
+	for _, r := range strings.TrimSpace(number) {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = append(digits, int(r-'0'))
+		case strings.ContainsRune(separators, r):
+		default:
+			return nil, fmt.Errorf("%w: unexpected character %q", ErrInvalidFormat, r)
+		}
+	}
+	if len(digits) == 0 {
+		return nil, ErrInvalidFormat
+	}
+	return digits, nil
+}
+
+func allEqual(digits []int) bool {
+	for _, d := range digits[1:] {
+		if d != digits[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// runeIndex return the position of the character in the dictionary counting runes, not bytes,
+// because the dictionaries have the Ñ.
+func runeIndex(dictionary string, c rune) int {
+	for i, r := range []rune(dictionary) {
+		if r == c {
+			return i
+		}
+	}
+	return -1
+}
+
+// validDate check a date with the format YYMMDD.
+func validDate(s string) bool {
+	_, err := time.Parse("060102", s)
+	return err == nil
+}
@@ -0,0 +1,54 @@
+package nationalid_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jackgris/mercadopago/nationalid"
+)
+
+func TestValidate(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		siteID      string
+		idType      string
+		number      string
+		expectedErr error
+	}{
+		{name: "Valid CPF", siteID: "MLB", idType: "CPF", number: "529.982.247-25"},
+		{name: "Invalid CPF", siteID: "MLB", idType: "CPF", number: "529.982.247-26", expectedErr: nationalid.ErrInvalidCheckDigit},
+		{name: "Repeated CPF", siteID: "MLB", idType: "CPF", number: "11111111111", expectedErr: nationalid.ErrInvalidFormat},
+		{name: "Valid CNPJ", siteID: "MLB", idType: "CNPJ", number: "11.222.333/0001-81"},
+		{name: "Invalid CNPJ", siteID: "MLB", idType: "CNPJ", number: "11.222.333/0001-82", expectedErr: nationalid.ErrInvalidCheckDigit},
+		{name: "Valid CUIT", siteID: "MLA", idType: "CUIT", number: "20-12345678-6"},
+		{name: "Valid CUIL", siteID: "MLA", idType: "CUIL", number: "27285963457"},
+		{name: "Invalid CUIT", siteID: "MLA", idType: "CUIT", number: "20-12345678-5", expectedErr: nationalid.ErrInvalidCheckDigit},
+		{name: "Invalid CUIT prefix", siteID: "MLA", idType: "CUIT", number: "21-12345678-6", expectedErr: nationalid.ErrInvalidFormat},
+		{name: "Valid RUT", siteID: "MLC", idType: "RUT", number: "12.345.678-5"},
+		{name: "Invalid RUT", siteID: "MLC", idType: "RUT", number: "12.345.678-K", expectedErr: nationalid.ErrInvalidCheckDigit},
+		{name: "Valid RFC", siteID: "MLM", idType: "RFC", number: "GODE561231GR8"},
+		{name: "Generic RFC", siteID: "MLM", idType: "RFC", number: "XAXX010101000"},
+		{name: "Invalid RFC", siteID: "MLM", idType: "RFC", number: "GODE561231GR9", expectedErr: nationalid.ErrInvalidCheckDigit},
+		{name: "Invalid RFC date", siteID: "MLM", idType: "RFC", number: "GODE561331GR8", expectedErr: nationalid.ErrInvalidFormat},
+		{name: "Valid CURP", siteID: "MLM", idType: "CURP", number: "HEGG560427MVZRRL04"},
+		{name: "Invalid CURP", siteID: "MLM", idType: "CURP", number: "HEGG560427MVZRRL05", expectedErr: nationalid.ErrInvalidCheckDigit},
+		{name: "Valid CI Uruguay", siteID: "MLU", idType: "CI", number: "1.234.567-2"},
+		{name: "Invalid CI Uruguay", siteID: "MLU", idType: "CI", number: "1.234.567-3", expectedErr: nationalid.ErrInvalidCheckDigit},
+		{name: "Valid CC Colombia", siteID: "MCO", idType: "CC", number: "1020304050"},
+		{name: "Invalid CC Colombia", siteID: "MCO", idType: "CC", number: "10203040506", expectedErr: nationalid.ErrInvalidFormat},
+		{name: "Valid DNI Peru", siteID: "MPE", idType: "DNI", number: "12345678"},
+		{name: "Invalid DNI Peru", siteID: "MPE", idType: "DNI", number: "1234567A", expectedErr: nationalid.ErrInvalidFormat},
+		{name: "Without validator", siteID: "MLA", idType: "Otro", number: "anything"},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := nationalid.Validate(tt.siteID, tt.idType, tt.number)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Receive error: %v | must be: %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package mercadopago
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// PaymentRequest is the data to create a payment. For card payments the token is the ID returned by GetCardToken.
+type PaymentRequest struct {
+	TransactionAmount   float64                `json:"transaction_amount"`
+	Token               string                 `json:"token,omitempty"`
+	Description         string                 `json:"description,omitempty"`
+	Installments        int                    `json:"installments,omitempty"`
+	PaymentMethodID     string                 `json:"payment_method_id"`
+	IssuerID            string                 `json:"issuer_id,omitempty"`
+	Payer               PaymentPayer           `json:"payer"`
+	BinaryMode          bool                   `json:"binary_mode,omitempty"`
+	Capture             *bool                  `json:"capture,omitempty"`
+	StatementDescriptor string                 `json:"statement_descriptor,omitempty"`
+	ExternalReference   string                 `json:"external_reference,omitempty"`
+	Metadata            map[string]interface{} `json:"metadata,omitempty"`
+	NotificationURL     string                 `json:"notification_url,omitempty"`
+	AdditionalInfo      *PaymentAdditionalInfo `json:"additional_info,omitempty"`
+	// IdempotencyKey avoid creating the payment twice when the request is retried, it's required and the same
+	// key must be sent in every retry of the payment. NewIdempotencyKey can generate it, to be stored with the order.
+	IdempotencyKey string `json:"-"`
+}
+
+// Validate check the required fields of the payment before calling the API.
+func (r PaymentRequest) Validate() error {
+	var errs ValidationErrors
+	if r.TransactionAmount <= 0 {
+		errs = append(errs, ValidationError{Field: "transaction_amount", Message: "must be greater than zero"})
+	}
+	if r.PaymentMethodID == "" {
+		errs = append(errs, ValidationError{Field: "payment_method_id", Message: "is required"})
+	}
+	if r.Token != "" && r.Installments < 1 {
+		errs = append(errs, ValidationError{Field: "installments", Message: "must be at least 1 for card payments"})
+	}
+	if r.Payer.Email == "" && r.Payer.ID == "" {
+		errs = append(errs, ValidationError{Field: "payer.email", Message: "is required"})
+	}
+	if r.IdempotencyKey == "" {
+		errs = append(errs, ValidationError{Field: "idempotency_key", Message: "is required"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+type PaymentPayer struct {
+	Type           string          `json:"type,omitempty"`
+	ID             string          `json:"id,omitempty"`
+	Email          string          `json:"email,omitempty"`
+	FirstName      string          `json:"first_name,omitempty"`
+	LastName       string          `json:"last_name,omitempty"`
+	EntityType     string          `json:"entity_type,omitempty"`
+	Identification *Identification `json:"identification,omitempty"`
+}
+
+// Validate check the identification of the payer against the types accepted in the site.
+func (p PaymentPayer) Validate(siteID string) error {
+	if p.Identification == nil {
+		return nil
+	}
+	return validateIdentification(siteID, *p.Identification, "payer.identification")
+}
+
+type PaymentAdditionalInfo struct {
+	IPAddress string                   `json:"ip_address,omitempty"`
+	Items     []Item                   `json:"items,omitempty"`
+	Payer     *AdditionalInfoPayer     `json:"payer,omitempty"`
+	Shipments *AdditionalInfoShipments `json:"shipments,omitempty"`
+}
+
+type Item struct {
+	ID          string  `json:"id,omitempty"`
+	Title       string  `json:"title,omitempty"`
+	Description string  `json:"description,omitempty"`
+	PictureURL  string  `json:"picture_url,omitempty"`
+	CategoryID  string  `json:"category_id,omitempty"`
+	Quantity    int     `json:"quantity,omitempty"`
+	UnitPrice   float64 `json:"unit_price,omitempty"`
+}
+
+type AdditionalInfoPayer struct {
+	FirstName        string   `json:"first_name,omitempty"`
+	LastName         string   `json:"last_name,omitempty"`
+	Phone            *Phone   `json:"phone,omitempty"`
+	Address          *Address `json:"address,omitempty"`
+	RegistrationDate string   `json:"registration_date,omitempty"`
+}
+
+type AdditionalInfoShipments struct {
+	ReceiverAddress *ReceiverAddress `json:"receiver_address,omitempty"`
+}
+
+type Phone struct {
+	AreaCode string `json:"area_code,omitempty"`
+	Number   string `json:"number,omitempty"`
+}
+
+type Address struct {
+	ZipCode      string `json:"zip_code,omitempty"`
+	StreetName   string `json:"street_name,omitempty"`
+	StreetNumber string `json:"street_number,omitempty"`
+}
+
+type ReceiverAddress struct {
+	ZipCode      string `json:"zip_code,omitempty"`
+	StateName    string `json:"state_name,omitempty"`
+	CityName     string `json:"city_name,omitempty"`
+	StreetName   string `json:"street_name,omitempty"`
+	StreetNumber string `json:"street_number,omitempty"`
+	Floor        string `json:"floor,omitempty"`
+	Apartment    string `json:"apartment,omitempty"`
+}
+
+type Payment struct {
+	ID                        int64                  `json:"id"`
+	DateCreated               string                 `json:"date_created"`
+	DateApproved              string                 `json:"date_approved"`
+	DateLastUpdated           string                 `json:"date_last_updated"`
+	DateOfExpiration          string                 `json:"date_of_expiration"`
+	MoneyReleaseDate          string                 `json:"money_release_date"`
+	OperationType             string                 `json:"operation_type"`
+	IssuerID                  string                 `json:"issuer_id"`
+	PaymentMethodID           string                 `json:"payment_method_id"`
+	PaymentTypeID             string                 `json:"payment_type_id"`
+	Status                    PaymentStatus          `json:"status"`
+	StatusDetail              PaymentStatusDetail    `json:"status_detail"`
+	CurrencyID                string                 `json:"currency_id"`
+	Description               string                 `json:"description"`
+	LiveMode                  bool                   `json:"live_mode"`
+	AuthorizationCode         string                 `json:"authorization_code"`
+	TransactionAmount         float64                `json:"transaction_amount"`
+	TransactionAmountRefunded float64                `json:"transaction_amount_refunded"`
+	CouponAmount              float64                `json:"coupon_amount"`
+	Installments              int                    `json:"installments"`
+	TransactionDetails        TransactionDetails     `json:"transaction_details"`
+	FeeDetails                []FeeDetail            `json:"fee_details"`
+	Captured                  bool                   `json:"captured"`
+	BinaryMode                bool                   `json:"binary_mode"`
+	StatementDescriptor       string                 `json:"statement_descriptor"`
+	ExternalReference         string                 `json:"external_reference"`
+	NotificationURL           string                 `json:"notification_url"`
+	Metadata                  map[string]interface{} `json:"metadata"`
+	Payer                     PaymentPayer           `json:"payer"`
+	Card                      PaymentCard            `json:"card"`
+	AdditionalInfo            PaymentAdditionalInfo  `json:"additional_info"`
+	Refunds                   []Refund               `json:"refunds"`
+}
+
+type TransactionDetails struct {
+	NetReceivedAmount        float64 `json:"net_received_amount"`
+	TotalPaidAmount          float64 `json:"total_paid_amount"`
+	InstallmentAmount        float64 `json:"installment_amount"`
+	OverpaidAmount           float64 `json:"overpaid_amount"`
+	FinancialInstitution     string  `json:"financial_institution"`
+	ExternalResourceURL      string  `json:"external_resource_url"`
+	PaymentMethodReferenceID string  `json:"payment_method_reference_id"`
+}
+
+type FeeDetail struct {
+	Type     FeeType  `json:"type"`
+	FeePayer FeePayer `json:"fee_payer"`
+	Amount   float64  `json:"amount"`
+}
+
+type PaymentCard struct {
+	ID              string     `json:"id"`
+	FirstSixDigits  string     `json:"first_six_digits"`
+	LastFourDigits  string     `json:"last_four_digits"`
+	ExpirationMonth int        `json:"expiration_month"`
+	ExpirationYear  int        `json:"expiration_year"`
+	DateCreated     string     `json:"date_created"`
+	DateLastUpdated string     `json:"date_last_updated"`
+	Cardholder      Cardholder `json:"cardholder"`
+}
+
+// CreatePayment create a payment, for card payments the token must be created before with GetCardToken.
+func (c *Client) CreatePayment(ctx context.Context, data PaymentRequest) (*Payment, error) {
+
+	if err := c.checkEnvironment(); err != nil {
+		return nil, err
+	}
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%sv1/payments", c.BaseURL)
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Idempotency-Key", data.IdempotencyKey)
+
+	req = req.WithContext(ctx)
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return nil, &errRes
+		}
+
+		return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	var payment Payment
+	if err := json.NewDecoder(res.Body).Decode(&payment); err != nil {
+		return nil, errors.New("Can't parse response")
+	}
+
+	// The payment was already created, so it's returned with the error to not lose its ID.
+	if err := c.checkLiveMode(payment.LiveMode); err != nil {
+		return &payment, err
+	}
+
+	return &payment, nil
+}
+
+// NewIdempotencyKey return a random key for the X-Idempotency-Key header.
+func NewIdempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,103 @@
+package mercadopago
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// CapturePayment capture a payment authorized before with Capture false. With amount zero the full amount is captured,
+// with a smaller amount the payment is partially captured and the rest is released.
+func (c *Client) CapturePayment(ctx context.Context, id int64, amount float64) (*Payment, error) {
+	payment, err := c.GetPayment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if payment.Status != PaymentStatusAuthorized {
+		return nil, fmt.Errorf("%w: can't capture a payment with status %s", ErrInvalidPaymentStatus, payment.Status)
+	}
+	if amount < 0 || amount > payment.TransactionAmount {
+		return nil, fmt.Errorf("capture amount must be between 0 and %g", payment.TransactionAmount)
+	}
+
+	data := struct {
+		Capture           bool    `json:"capture"`
+		TransactionAmount float64 `json:"transaction_amount,omitempty"`
+	}{
+		Capture:           true,
+		TransactionAmount: amount,
+	}
+
+	return c.updatePayment(ctx, id, data)
+}
+
+// CancelPayment cancel a payment that is not approved yet, like an authorized payment that won't be captured
+// or a pending ticket payment.
+func (c *Client) CancelPayment(ctx context.Context, id int64) (*Payment, error) {
+	payment, err := c.GetPayment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	switch payment.Status {
+	case PaymentStatusAuthorized, PaymentStatusPending, PaymentStatusInProcess:
+	default:
+		return nil, fmt.Errorf("%w: can't cancel a payment with status %s", ErrInvalidPaymentStatus, payment.Status)
+	}
+
+	data := struct {
+		Status PaymentStatus `json:"status"`
+	}{
+		Status: PaymentStatusCancelled,
+	}
+
+	return c.updatePayment(ctx, id, data)
+}
+
+func (c *Client) updatePayment(ctx context.Context, id int64, data interface{}) (*Payment, error) {
+
+	if err := c.checkEnvironment(); err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%sv1/payments/%d", c.BaseURL, id)
+
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return nil, &errRes
+		}
+
+		return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	var payment Payment
+	if err := json.NewDecoder(res.Body).Decode(&payment); err != nil {
+		return nil, errors.New("Can't parse response")
+	}
+
+	// The payment was already changed, so it's returned with the error.
+	if err := c.checkLiveMode(payment.LiveMode); err != nil {
+		return &payment, err
+	}
+
+	return &payment, nil
+}
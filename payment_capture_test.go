@@ -0,0 +1,168 @@
+package mercadopago_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestCaptureAndCancelPayment(t *testing.T) {
+
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+
+	tests := []struct {
+		name           string
+		status         mercadopago.PaymentStatus
+		cancel         bool
+		amount         float64
+		expectedBody   map[string]interface{}
+		expectedStatus mercadopago.PaymentStatus
+		expectedErr    error
+	}{
+		{
+			name:           "Full capture",
+			status:         "authorized",
+			expectedBody:   map[string]interface{}{"capture": true},
+			expectedStatus: "approved",
+		},
+		{
+			name:           "Partial capture",
+			status:         "authorized",
+			amount:         400,
+			expectedBody:   map[string]interface{}{"capture": true, "transaction_amount": 400.0},
+			expectedStatus: "approved",
+		},
+		{
+			name:        "Capture more than authorized",
+			status:      "authorized",
+			amount:      2000,
+			expectedErr: errors.New("capture amount must be between 0 and 1000"),
+		},
+		{
+			name:        "Capture approved payment",
+			status:      "approved",
+			expectedErr: mercadopago.ErrInvalidPaymentStatus,
+		},
+		{
+			name:           "Cancel pending payment",
+			status:         "pending",
+			cancel:         true,
+			expectedBody:   map[string]interface{}{"status": "cancelled"},
+			expectedStatus: "cancelled",
+		},
+		{
+			name:           "Cancel authorized payment",
+			status:         "authorized",
+			cancel:         true,
+			expectedBody:   map[string]interface{}{"status": "cancelled"},
+			expectedStatus: "cancelled",
+		},
+		{
+			name:        "Cancel approved payment",
+			status:      "approved",
+			cancel:      true,
+			expectedErr: mercadopago.ErrInvalidPaymentStatus,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			payment := mercadopago.Payment{ID: 20359978, Status: tt.status, TransactionAmount: 1000}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				urlPAth := "/v1/payments/20359978"
+				if urlPAth != r.URL.Path {
+					t.Fatalf("Path URL expected is %s but receive %s", urlPAth, r.URL.Path)
+				}
+
+				switch r.Method {
+				case http.MethodGet:
+				case http.MethodPut:
+					var body map[string]interface{}
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Fatal(err)
+					}
+					if len(body) != len(tt.expectedBody) {
+						t.Fatalf("Expected body %v but receive %v", tt.expectedBody, body)
+					}
+					for key, value := range tt.expectedBody {
+						if body[key] != value {
+							t.Fatalf("Expected body %v but receive %v", tt.expectedBody, body)
+						}
+					}
+					payment.Status = tt.expectedStatus
+					payment.Captured = !tt.cancel
+				default:
+					t.Fatalf("Unexpected HTTP Method %s", r.Method)
+				}
+
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				w.WriteHeader(http.StatusOK)
+				data, _ := json.Marshal(payment)
+				_, _ = w.Write(data)
+			}))
+			defer server.Close()
+
+			client := mercadopago.NewClient(server.URL+"/", accessToken)
+			var got *mercadopago.Payment
+			var err error
+			if tt.cancel {
+				got, err = client.CancelPayment(context.Background(), payment.ID)
+			} else {
+				got, err = client.CapturePayment(context.Background(), payment.ID, tt.amount)
+			}
+
+			if tt.expectedErr != nil {
+				if err == nil || (!errors.Is(err, tt.expectedErr) && err.Error() != tt.expectedErr.Error()) {
+					t.Fatalf("Receive error: %v | must be: %v", err, tt.expectedErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Status != tt.expectedStatus {
+				t.Fatalf("Expected status %s but receive %s", tt.expectedStatus, got.Status)
+			}
+		})
+	}
+}
+
+func TestCapturePaymentLiveModeMismatch(t *testing.T) {
+
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payment := mercadopago.Payment{ID: 20359978, Status: mercadopago.PaymentStatusAuthorized, TransactionAmount: 1000}
+		if r.Method == http.MethodPut {
+			payment.Status = mercadopago.PaymentStatusApproved
+			payment.Captured = true
+			payment.LiveMode = true
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(payment)
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	client := mercadopago.NewClient(server.URL+"/", accessToken)
+	if err := client.SetEnvironment(mercadopago.EnvironmentSandbox); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := client.CapturePayment(context.Background(), 20359978, 0)
+	if !errors.Is(err, mercadopago.ErrEnvironmentMismatch) {
+		t.Fatalf("Receive error: %v | must be: %v", err, mercadopago.ErrEnvironmentMismatch)
+	}
+	if got == nil || got.Status != mercadopago.PaymentStatusApproved {
+		t.Fatalf("Expected the captured payment with the error but receive %v", got)
+	}
+}
@@ -45,22 +45,41 @@ func (c *Client) PaymentMethods(ctx context.Context) (PaymentMethods, error) {
 type PaymentMethods []PaymentMethod
 
 type PaymentMethod struct {
-	ID                    string        `json:"id"`
-	Name                  string        `json:"name"`
-	PaymentTypeID         string        `json:"payment_type_id"`
-	Status                string        `json:"status"`
-	SecureThumbnail       string        `json:"secure_thumbnail"`
-	Thumbnail             string        `json:"thumbnail"`
-	DeferredCapture       string        `json:"deferred_capture"`
-	Settings              []Settings    `json:"settings"`
-	AdditionalInfoNeeded  []string      `json:"additional_info_needed"`
-	MinAllowedAmount      float64       `json:"min_allowed_amount"`
-	MaxAllowedAmount      int           `json:"max_allowed_amount"`
-	AccreditationTime     int           `json:"accreditation_time"`
-	FinancialInstitutions []interface{} `json:"financial_institutions"`
-	ProcessingModes       []string      `json:"processing_modes"`
+	ID                    string                 `json:"id"`
+	Name                  string                 `json:"name"`
+	PaymentTypeID         string                 `json:"payment_type_id"`
+	Status                string                 `json:"status"`
+	SecureThumbnail       string                 `json:"secure_thumbnail"`
+	Thumbnail             string                 `json:"thumbnail"`
+	DeferredCapture       string                 `json:"deferred_capture"`
+	Settings              []Settings             `json:"settings"`
+	AdditionalInfoNeeded  []AdditionalInfo       `json:"additional_info_needed"`
+	MinAllowedAmount      float64                `json:"min_allowed_amount"`
+	MaxAllowedAmount      int                    `json:"max_allowed_amount"`
+	AccreditationTime     int                    `json:"accreditation_time"`
+	FinancialInstitutions []FinancialInstitution `json:"financial_institutions"`
+	ProcessingModes       []string               `json:"processing_modes"`
 }
 
+// FinancialInstitution is the bank that process the payment, for example in the PSE payments of Colombia.
+type FinancialInstitution struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+}
+
+// AdditionalInfo is the data that the payer must complete to pay with the payment method.
+type AdditionalInfo string
+
+const (
+	InfoCardholderName                 AdditionalInfo = "cardholder_name"
+	InfoCardholderIdentificationType   AdditionalInfo = "cardholder_identification_type"
+	InfoCardholderIdentificationNumber AdditionalInfo = "cardholder_identification_number"
+	InfoIssuerID                       AdditionalInfo = "issuer_id"
+	InfoPayerEmail                     AdditionalInfo = "payer_email"
+	InfoEntityType                     AdditionalInfo = "entity_type"
+	InfoFinancialInstitution           AdditionalInfo = "financial_institution"
+)
+
 type Settings struct {
 	CardNumber   CardNumber   `json:"card_number"`
 	Bin          Bin          `json:"bin"`
@@ -0,0 +1,170 @@
+package mercadopago
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// snapshots are copies of the payment methods of each site, in files named with the site ID.
+//
+//go:embed snapshot/*.json
+var snapshots embed.FS
+
+// SnapshotPaymentMethods return the copy of the payment methods of the site embedded in the package, like MLA.
+// It can be outdated, so use it only when the API is unreachable. It return ErrSnapshotNotFound when the
+// package doesn't have a copy for the site, because the payment methods of other site must never be used.
+func SnapshotPaymentMethods(siteID string) (PaymentMethods, error) {
+	data, err := snapshots.ReadFile(path.Join("snapshot", siteID+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrSnapshotNotFound, siteID)
+	}
+	return LoadPaymentMethods(bytes.NewReader(data))
+}
+
+// LoadPaymentMethods read the payment methods in the same JSON format returned by the API.
+func LoadPaymentMethods(r io.Reader) (PaymentMethods, error) {
+	var paymentMethods PaymentMethods
+	if err := json.NewDecoder(r).Decode(&paymentMethods); err != nil {
+		return nil, err
+	}
+	return paymentMethods, nil
+}
+
+type PaymentMethodsCacheConfig struct {
+	// TTL is the time that the payment methods are fresh, by default 1 hour.
+	TTL time.Duration
+	// StaleTTL is the time after the TTL that the stale payment methods are still returned while they
+	// are refreshed in background. With zero the payment methods are refreshed before returning them.
+	StaleTTL time.Duration
+	// RefreshTimeout limit the time of the background refresh, by default 30 seconds.
+	RefreshTimeout time.Duration
+	// Fallback are the payment methods returned when the API is unreachable and there isn't a cached copy.
+	// Use SnapshotPaymentMethods to fall back to the copy of the site embedded in the package.
+	Fallback PaymentMethods
+}
+
+// PaymentMethodsCache keep the payment methods in memory, because they change rarely.
+// Concurrent calls that need to fetch the payment methods make only one request to the API.
+type PaymentMethodsCache struct {
+	client     *Client
+	config     PaymentMethodsCacheConfig
+	group      singleflight.Group
+	refreshing atomic.Bool
+
+	mu        sync.RWMutex
+	methods   PaymentMethods
+	fetchedAt time.Time
+	// generation change with every Invalidate, so the fetches started before it don't fill the cache
+	// with old payment methods, and the new callers don't wait for them.
+	generation uint64
+}
+
+// paymentMethodsKey is the key of the fetches of the generation in the singleflight group.
+func paymentMethodsKey(generation uint64) string {
+	return "payment_methods/" + strconv.FormatUint(generation, 10)
+}
+
+// NewPaymentMethodsCache create a cache of the payment methods returned by the client.
+func NewPaymentMethodsCache(client *Client, config PaymentMethodsCacheConfig) *PaymentMethodsCache {
+	if config.TTL <= 0 {
+		config.TTL = time.Hour
+	}
+	if config.RefreshTimeout <= 0 {
+		config.RefreshTimeout = 30 * time.Second
+	}
+
+	return &PaymentMethodsCache{
+		client: client,
+		config: config,
+	}
+}
+
+// PaymentMethods return the cached payment methods, fetching them from the API when they are expired.
+// The result is shared by all the callers, so it must not be modified.
+func (pc *PaymentMethodsCache) PaymentMethods(ctx context.Context) (PaymentMethods, error) {
+	pc.mu.RLock()
+	methods, fetchedAt, generation := pc.methods, pc.fetchedAt, pc.generation
+	pc.mu.RUnlock()
+
+	age := time.Now().Sub(fetchedAt)
+	switch {
+	case methods != nil && age < pc.config.TTL:
+		return methods, nil
+	case methods != nil && age < pc.config.TTL+pc.config.StaleTTL:
+		pc.refreshInBackground(generation)
+		return methods, nil
+	}
+
+	ch := pc.group.DoChan(paymentMethodsKey(generation), pc.fetch(generation))
+	select {
+	case res := <-ch:
+		if res.Err == nil {
+			return res.Val.(PaymentMethods), nil
+		}
+		if methods != nil {
+			return methods, nil
+		}
+		if pc.config.Fallback != nil {
+			return pc.config.Fallback, nil
+		}
+		return nil, res.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Invalidate remove the cached payment methods, so the next call fetch them again.
+// The fetches in flight when it's called don't fill the cache.
+func (pc *PaymentMethodsCache) Invalidate() {
+	pc.mu.Lock()
+	pc.methods = nil
+	pc.fetchedAt = time.Time{}
+	pc.generation++
+	pc.mu.Unlock()
+}
+
+// refreshInBackground start a refresh of the payment methods, unless there is one running.
+func (pc *PaymentMethodsCache) refreshInBackground(generation uint64) {
+	if !pc.refreshing.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer pc.refreshing.Store(false)
+		_, _, _ = pc.group.Do(paymentMethodsKey(generation), pc.fetch(generation))
+	}()
+}
+
+// fetch return the function that get the payment methods from the API without the context of the caller,
+// because the result is shared with the other callers and it must not be canceled when one of them give up.
+// The result is only cached when the cache wasn't invalidated after the generation.
+func (pc *PaymentMethodsCache) fetch(generation uint64) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), pc.config.RefreshTimeout)
+		defer cancel()
+
+		methods, err := pc.client.PaymentMethods(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		pc.mu.Lock()
+		if pc.generation == generation {
+			pc.methods = methods
+			pc.fetchedAt = time.Now()
+		}
+		pc.mu.Unlock()
+
+		return methods, nil
+	}
+}
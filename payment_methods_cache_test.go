@@ -0,0 +1,234 @@
+package mercadopago_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func newPaymentMethodsServer(t *testing.T, status int, delay time.Duration, requests *int32) *httptest.Server {
+	t.Helper()
+
+	data, err := os.ReadFile("./testdata/payment_methods_data.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		time.Sleep(delay)
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			_, _ = w.Write([]byte(`{"message": "internal error", "error": "internal_error", "status": 500}`))
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestPaymentMethodsCacheDeduplicate(t *testing.T) {
+
+	var requests int32
+	server := newPaymentMethodsServer(t, http.StatusOK, 50*time.Millisecond, &requests)
+	client := mercadopago.NewClient(server.URL+"/", "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344")
+	cache := mercadopago.NewPaymentMethodsCache(client, mercadopago.PaymentMethodsCacheConfig{TTL: time.Hour})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			methods, err := cache.PaymentMethods(context.Background())
+			if err != nil || len(methods) == 0 {
+				t.Errorf("Expected payment methods but receive error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if _, err := cache.PaymentMethods(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Fatalf("Expected 1 request to the API but receive %d", got)
+	}
+
+	cache.Invalidate()
+	if _, err := cache.PaymentMethods(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Fatalf("Expected 2 requests to the API after invalidate but receive %d", got)
+	}
+}
+
+func TestPaymentMethodsCacheStaleWhileRevalidate(t *testing.T) {
+
+	var requests int32
+	server := newPaymentMethodsServer(t, http.StatusOK, 0, &requests)
+	client := mercadopago.NewClient(server.URL+"/", "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344")
+	cache := mercadopago.NewPaymentMethodsCache(client, mercadopago.PaymentMethodsCacheConfig{
+		TTL:      20 * time.Millisecond,
+		StaleTTL: time.Hour,
+	})
+
+	if _, err := cache.PaymentMethods(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(30 * time.Millisecond)
+
+	methods, err := cache.PaymentMethods(context.Background())
+	if err != nil || len(methods) == 0 {
+		t.Fatalf("Expected stale payment methods but receive error: %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(&requests) < 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected a background refresh of the stale payment methods")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestPaymentMethodsCacheFallback(t *testing.T) {
+
+	snapshot, err := mercadopago.SnapshotPaymentMethods("MLA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, method := range snapshot {
+		switch method.DeferredCapture {
+		case mercadopago.DeferredCaptureSupported, "unsupported", "does_not_apply":
+		default:
+			t.Fatalf("Unexpected deferred capture %q in the snapshot of %s", method.DeferredCapture, method.ID)
+		}
+	}
+	if _, err := mercadopago.SnapshotPaymentMethods("MLB"); !errors.Is(err, mercadopago.ErrSnapshotNotFound) {
+		t.Fatalf("Receive error: %v | must be: %v", err, mercadopago.ErrSnapshotNotFound)
+	}
+
+	var requests int32
+	server := newPaymentMethodsServer(t, http.StatusInternalServerError, 0, &requests)
+	client := mercadopago.NewClient(server.URL+"/", "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344")
+
+	cache := mercadopago.NewPaymentMethodsCache(client, mercadopago.PaymentMethodsCacheConfig{})
+	if _, err := cache.PaymentMethods(context.Background()); err == nil {
+		t.Fatal("Expected an error without fallback")
+	}
+
+	cache = mercadopago.NewPaymentMethodsCache(client, mercadopago.PaymentMethodsCacheConfig{Fallback: snapshot})
+	methods, err := cache.PaymentMethods(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(methods) != len(snapshot) {
+		t.Fatalf("Expected %d payment methods from the snapshot but receive %d", len(snapshot), len(methods))
+	}
+}
+
+func TestPaymentMethodsCacheSingleBackgroundRefresh(t *testing.T) {
+
+	var requests int32
+	server := newPaymentMethodsServer(t, http.StatusOK, 0, &requests)
+	client := mercadopago.NewClient(server.URL+"/", "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344")
+	cache := mercadopago.NewPaymentMethodsCache(client, mercadopago.PaymentMethodsCacheConfig{
+		TTL:      20 * time.Millisecond,
+		StaleTTL: time.Hour,
+	})
+	if _, err := cache.PaymentMethods(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the next requests are slow, so all the stale hits happen while the refresh is running
+	release := make(chan struct{})
+	server.Config.Handler = blockingHandler(server.Config.Handler, release)
+	time.Sleep(30 * time.Millisecond)
+
+	before := runtime.NumGoroutine()
+	for i := 0; i < 200; i++ {
+		if _, err := cache.PaymentMethods(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if started := runtime.NumGoroutine() - before; started > 20 {
+		t.Fatalf("Expected only one background refresh but %d goroutines were started", started)
+	}
+	close(release)
+}
+
+func TestPaymentMethodsCacheInvalidateDuringFetch(t *testing.T) {
+
+	data, err := os.ReadFile("./testdata/payment_methods_data.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := `[{"id": "old", "payment_type_id": "credit_card", "status": "active"}]`
+
+	var requests int32
+	arrived := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if atomic.AddInt32(&requests, 1) == 1 {
+			close(arrived)
+			<-release
+			_, _ = w.Write([]byte(old))
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	client := mercadopago.NewClient(server.URL+"/", "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344")
+	cache := mercadopago.NewPaymentMethodsCache(client, mercadopago.PaymentMethodsCacheConfig{TTL: time.Hour})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = cache.PaymentMethods(context.Background())
+	}()
+	<-arrived
+
+	cache.Invalidate()
+	methods, err := cache.PaymentMethods(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(methods) == 1 {
+		t.Fatal("A call after Invalidate must not receive the payment methods of the fetch in flight")
+	}
+
+	close(release)
+	<-done
+
+	methods, err = cache.PaymentMethods(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(methods) == 1 || atomic.LoadInt32(&requests) != 2 {
+		t.Fatalf("The fetch started before Invalidate must not fill the cache, receive %d methods after %d requests", len(methods), requests)
+	}
+}
+
+// blockingHandler wait until release is closed before calling the handler.
+func blockingHandler(h http.Handler, release chan struct{}) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,149 @@
+package mercadopago
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PaymentMethodsDiff is the list of changes between two copies of the payment methods that affect a checkout.
+type PaymentMethodsDiff struct {
+	Added              []string           `json:"added"`
+	Removed            []string           `json:"removed"`
+	StatusChanges      []StatusChange     `json:"status_changes"`
+	AmountLimitChanges []AmountChange     `json:"amount_limit_changes"`
+	BinPatternChanges  []BinPatternChange `json:"bin_pattern_changes"`
+}
+
+type StatusChange struct {
+	ID  string `json:"id"`
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+type AmountChange struct {
+	ID     string  `json:"id"`
+	OldMin float64 `json:"old_min"`
+	NewMin float64 `json:"new_min"`
+	OldMax int     `json:"old_max"`
+	NewMax int     `json:"new_max"`
+}
+
+type BinPatternChange struct {
+	ID  string   `json:"id"`
+	Old []string `json:"old"`
+	New []string `json:"new"`
+}
+
+// DiffPaymentMethods compare two copies of the payment methods, for example the snapshot embedded in the package
+// and the payment methods returned by the API. The changes are sorted by the payment method ID.
+func DiffPaymentMethods(before, after PaymentMethods) PaymentMethodsDiff {
+	diff := PaymentMethodsDiff{
+		Added:              []string{},
+		Removed:            []string{},
+		StatusChanges:      []StatusChange{},
+		AmountLimitChanges: []AmountChange{},
+		BinPatternChanges:  []BinPatternChange{},
+	}
+
+	oldByID := make(map[string]PaymentMethod, len(before))
+	for _, method := range before {
+		oldByID[method.ID] = method
+	}
+	newByID := make(map[string]PaymentMethod, len(after))
+	for _, method := range after {
+		newByID[method.ID] = method
+	}
+
+	for _, id := range sortedIDs(oldByID) {
+		if _, ok := newByID[id]; !ok {
+			diff.Removed = append(diff.Removed, id)
+		}
+	}
+
+	for _, id := range sortedIDs(newByID) {
+		n := newByID[id]
+		o, ok := oldByID[id]
+		if !ok {
+			diff.Added = append(diff.Added, id)
+			continue
+		}
+
+		if o.Status != n.Status {
+			diff.StatusChanges = append(diff.StatusChanges, StatusChange{ID: id, Old: o.Status, New: n.Status})
+		}
+		if o.MinAllowedAmount != n.MinAllowedAmount || o.MaxAllowedAmount != n.MaxAllowedAmount {
+			diff.AmountLimitChanges = append(diff.AmountLimitChanges, AmountChange{
+				ID:     id,
+				OldMin: o.MinAllowedAmount,
+				NewMin: n.MinAllowedAmount,
+				OldMax: o.MaxAllowedAmount,
+				NewMax: n.MaxAllowedAmount,
+			})
+		}
+		oldPatterns, newPatterns := binPatterns(o), binPatterns(n)
+		if strings.Join(oldPatterns, "\n") != strings.Join(newPatterns, "\n") {
+			diff.BinPatternChanges = append(diff.BinPatternChanges, BinPatternChange{ID: id, Old: oldPatterns, New: newPatterns})
+		}
+	}
+
+	return diff
+}
+
+// IsEmpty report if there are no changes.
+func (d PaymentMethodsDiff) IsEmpty() bool {
+	return len(d.Added) == 0 &&
+		len(d.Removed) == 0 &&
+		len(d.StatusChanges) == 0 &&
+		len(d.AmountLimitChanges) == 0 &&
+		len(d.BinPatternChanges) == 0
+}
+
+// String return the changes as a text report.
+func (d PaymentMethodsDiff) String() string {
+	if d.IsEmpty() {
+		return "No changes\n"
+	}
+
+	var b strings.Builder
+	for _, id := range d.Added {
+		fmt.Fprintf(&b, "+ %s: added\n", id)
+	}
+	for _, id := range d.Removed {
+		fmt.Fprintf(&b, "- %s: removed\n", id)
+	}
+	for _, c := range d.StatusChanges {
+		fmt.Fprintf(&b, "~ %s: status %s -> %s\n", c.ID, c.Old, c.New)
+	}
+	for _, c := range d.AmountLimitChanges {
+		fmt.Fprintf(&b, "~ %s: amount limits [%g, %d] -> [%g, %d]\n", c.ID, c.OldMin, c.OldMax, c.NewMin, c.NewMax)
+	}
+	for _, c := range d.BinPatternChanges {
+		fmt.Fprintf(&b, "~ %s: bin pattern changed\n", c.ID)
+		for _, p := range c.Old {
+			fmt.Fprintf(&b, "    - %s\n", p)
+		}
+		for _, p := range c.New {
+			fmt.Fprintf(&b, "    + %s\n", p)
+		}
+	}
+
+	return b.String()
+}
+
+func binPatterns(method PaymentMethod) []string {
+	patterns := make([]string, 0, len(method.Settings))
+	for _, settings := range method.Settings {
+		patterns = append(patterns, settings.Bin.Pattern)
+	}
+	return patterns
+}
+
+func sortedIDs(methods map[string]PaymentMethod) []string {
+	ids := make([]string, 0, len(methods))
+	for id := range methods {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,71 @@
+package mercadopago_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestDiffPaymentMethods(t *testing.T) {
+
+	before := loadPaymentMethods(t)
+	if d := mercadopago.DiffPaymentMethods(before, before); !d.IsEmpty() {
+		t.Fatalf("The same payment methods should not have changes but receive: %s", d)
+	}
+
+	// Copy the payment methods to change them without changing the originals
+	data, _ := json.Marshal(before)
+	var after mercadopago.PaymentMethods
+	if err := json.Unmarshal(data, &after); err != nil {
+		t.Fatal(err)
+	}
+
+	after = after.Filter(func(method mercadopago.PaymentMethod) bool {
+		return method.ID != "tarshop"
+	})
+	after = append(after, mercadopago.PaymentMethod{ID: "pix", PaymentTypeID: "bank_transfer", Status: "active"})
+	for i := range after {
+		switch after[i].ID {
+		case "sol":
+			after[i].Status = mercadopago.PaymentMethodStatusActive
+		case "amex":
+			after[i].MaxAllowedAmount = 3000000
+		case "cmr":
+			after[i].Settings[0].Bin.Pattern = "^(557039|557040)"
+		}
+	}
+
+	got := mercadopago.DiffPaymentMethods(before, after)
+	expected := mercadopago.PaymentMethodsDiff{
+		Added:   []string{"pix"},
+		Removed: []string{"tarshop"},
+		StatusChanges: []mercadopago.StatusChange{
+			{ID: "sol", Old: "testing", New: "active"},
+		},
+		AmountLimitChanges: []mercadopago.AmountChange{
+			{ID: "amex", OldMin: 3, NewMin: 3, OldMax: 2500000, NewMax: 3000000},
+		},
+		BinPatternChanges: []mercadopago.BinPatternChange{
+			{ID: "cmr", Old: []string{"^(557039)"}, New: []string{"^(557039|557040)"}},
+		},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected changes %+v but receive %+v", expected, got)
+	}
+
+	report := got.String()
+	for _, line := range []string{
+		"+ pix: added",
+		"- tarshop: removed",
+		"~ sol: status testing -> active",
+		"~ amex: amount limits [3, 2500000] -> [3, 3000000]",
+		"~ cmr: bin pattern changed",
+	} {
+		if !strings.Contains(report, line) {
+			t.Fatalf("Expected line %q in report:\n%s", line, report)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package mercadopago
+
+const (
+	PaymentTypeCreditCard   = "credit_card"
+	PaymentTypeDebitCard    = "debit_card"
+	PaymentTypePrepaidCard  = "prepaid_card"
+	PaymentTypeTicket       = "ticket"
+	PaymentTypeATM          = "atm"
+	PaymentTypeBankTransfer = "bank_transfer"
+	PaymentTypeAccountMoney = "account_money"
+)
+
+const (
+	PaymentMethodStatusActive   = "active"
+	PaymentMethodStatusTesting  = "testing"
+	PaymentMethodStatusInactive = "deactive"
+)
+
+// DeferredCaptureSupported is the value of PaymentMethod.DeferredCapture for the methods that can authorize a payment and capture it later.
+const DeferredCaptureSupported = "supported"
+
+// Filter return the payment methods where the function return true.
+func (pm PaymentMethods) Filter(keep func(PaymentMethod) bool) PaymentMethods {
+	var result PaymentMethods
+	for _, method := range pm {
+		if keep(method) {
+			result = append(result, method)
+		}
+	}
+	return result
+}
+
+// ByID return the payment method with the ID, like visa or master.
+func (pm PaymentMethods) ByID(id string) (PaymentMethod, bool) {
+	for _, method := range pm {
+		if method.ID == id {
+			return method, true
+		}
+	}
+	return PaymentMethod{}, false
+}
+
+// ByType return the payment methods of the payment type, like credit_card or ticket.
+func (pm PaymentMethods) ByType(paymentTypeID string) PaymentMethods {
+	return pm.Filter(func(method PaymentMethod) bool {
+		return method.PaymentTypeID == paymentTypeID
+	})
+}
+
+// Active return the payment methods with status active.
+func (pm PaymentMethods) Active() PaymentMethods {
+	return pm.Filter(func(method PaymentMethod) bool {
+		return method.Status == PaymentMethodStatusActive
+	})
+}
+
+// AllowingAmount return the payment methods that accept the amount, between the min and max allowed amounts.
+func (pm PaymentMethods) AllowingAmount(amount float64) PaymentMethods {
+	return pm.Filter(func(method PaymentMethod) bool {
+		return method.AllowsAmount(amount)
+	})
+}
+
+// SupportingDeferredCapture return the payment methods that can authorize a payment and capture it later.
+func (pm PaymentMethods) SupportingDeferredCapture() PaymentMethods {
+	return pm.Filter(func(method PaymentMethod) bool {
+		return method.DeferredCapture == DeferredCaptureSupported
+	})
+}
+
+// WithProcessingMode return the payment methods that support the processing mode, like aggregator or gateway.
+func (pm PaymentMethods) WithProcessingMode(mode string) PaymentMethods {
+	return pm.Filter(func(method PaymentMethod) bool {
+		return contains(method.ProcessingModes, mode)
+	})
+}
+
+// RequiringInfo return the payment methods that need the additional info field, like issuer_id or cardholder_name.
+func (pm PaymentMethods) RequiringInfo(field AdditionalInfo) PaymentMethods {
+	return pm.Filter(func(method PaymentMethod) bool {
+		return method.RequiresInfo(field)
+	})
+}
+
+// AllowsAmount report if the amount is between the min and max allowed amounts of the payment method.
+func (m PaymentMethod) AllowsAmount(amount float64) bool {
+	return amount >= m.MinAllowedAmount && amount <= float64(m.MaxAllowedAmount)
+}
+
+// RequiresInfo report if the payment method need the additional info field.
+func (m PaymentMethod) RequiresInfo(field AdditionalInfo) bool {
+	for _, info := range m.AdditionalInfoNeeded {
+		if info == field {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package mercadopago_test
+
+import (
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestPaymentMethodsFilters(t *testing.T) {
+
+	paymentMethods := loadPaymentMethods(t)
+
+	if got, ok := paymentMethods.ByID("visa"); !ok || got.Name != "Visa" {
+		t.Fatalf("Expected the visa payment method but receive %v", got)
+	}
+	if _, ok := paymentMethods.ByID("unknown"); ok {
+		t.Fatal("Unknown payment method should not be found")
+	}
+
+	tests := []struct {
+		name     string
+		got      mercadopago.PaymentMethods
+		expected []string
+	}{
+		{
+			name:     "Tickets",
+			got:      paymentMethods.ByType(mercadopago.PaymentTypeTicket),
+			expected: []string{"pagofacil", "rapipago", "cobroexpress"},
+		},
+		{
+			name:     "Active tickets",
+			got:      paymentMethods.ByType(mercadopago.PaymentTypeTicket).Active(),
+			expected: []string{"pagofacil", "rapipago"},
+		},
+		{
+			name:     "Allowing a small amount",
+			got:      paymentMethods.AllowingAmount(2),
+			expected: []string{"debin_transfer", "sucredito", "argencard", "sol"},
+		},
+		{
+			name:     "Allowing a big amount",
+			got:      paymentMethods.ByType(mercadopago.PaymentTypeTicket).AllowingAmount(300000),
+			expected: []string{"pagofacil", "rapipago"},
+		},
+		{
+			name:     "Debit cards with deferred capture",
+			got:      paymentMethods.ByType(mercadopago.PaymentTypeDebitCard).SupportingDeferredCapture(),
+			expected: nil,
+		},
+		{
+			name:     "Requiring issuer",
+			got:      paymentMethods.RequiringInfo("issuer_id"),
+			expected: []string{"master", "sucredito", "debmaster", "sol"},
+		},
+		{
+			name:     "Gateway processing mode",
+			got:      paymentMethods.WithProcessingMode("gateway"),
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.got) != len(tt.expected) {
+				t.Fatalf("Expected %v but receive %d payment methods", tt.expected, len(tt.got))
+			}
+			for i, id := range tt.expected {
+				if tt.got[i].ID != id {
+					t.Fatalf("Expected payment method %s but receive %s", id, tt.got[i].ID)
+				}
+			}
+		})
+	}
+
+	if len(paymentMethods.WithProcessingMode("aggregator")) != len(paymentMethods) {
+		t.Fatal("All the payment methods should support the aggregator processing mode")
+	}
+	if len(paymentMethods.SupportingDeferredCapture()) == 0 {
+		t.Fatal("Credit cards should support deferred capture")
+	}
+}
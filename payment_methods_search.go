@@ -0,0 +1,117 @@
+package mercadopago
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RequestPaymentMethodsSearch are the filters to search the payment methods, all of them are optional.
+type RequestPaymentMethodsSearch struct {
+	ID             string
+	PaymentTypeID  string
+	Bins           []string
+	SiteID         string
+	Marketplace    string
+	ProcessingMode string
+	Status         string
+	Limit          int
+	Offset         int
+}
+
+func (r RequestPaymentMethodsSearch) query() url.Values {
+	q := url.Values{}
+	if r.ID != "" {
+		q.Set("id", r.ID)
+	}
+	if r.PaymentTypeID != "" {
+		q.Set("payment_type_id", r.PaymentTypeID)
+	}
+	if len(r.Bins) > 0 {
+		q.Set("bins", strings.Join(r.Bins, ","))
+	}
+	if r.SiteID != "" {
+		q.Set("site_id", r.SiteID)
+	}
+	if r.Marketplace != "" {
+		q.Set("marketplace", r.Marketplace)
+	}
+	if r.ProcessingMode != "" {
+		q.Set("processing_mode", r.ProcessingMode)
+	}
+	if r.Status != "" {
+		q.Set("status", r.Status)
+	}
+	if r.Limit > 0 {
+		q.Set("limit", strconv.Itoa(r.Limit))
+	}
+	if r.Offset > 0 {
+		q.Set("offset", strconv.Itoa(r.Offset))
+	}
+	return q
+}
+
+type PaymentMethodsSearch struct {
+	Paging  Paging                      `json:"paging"`
+	Results []PaymentMethodSearchResult `json:"results"`
+}
+
+// PaymentMethodSearchResult is a payment method of the search, it has the site and the issuer of the method.
+type PaymentMethodSearchResult struct {
+	PaymentMethod
+	SiteID      string `json:"site_id"`
+	Marketplace string `json:"marketplace"`
+	Issuer      Issuer `json:"issuer"`
+}
+
+// SearchPaymentMethods search the payment methods of any site, for example by bin, it return one page of results.
+func (c *Client) SearchPaymentMethods(ctx context.Context, search RequestPaymentMethodsSearch) (*PaymentMethodsSearch, error) {
+
+	url := fmt.Sprintf("%sv1/payment_methods/search?%s", c.BaseURL, search.query().Encode())
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return nil, &errRes
+		}
+
+		return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	var result PaymentMethodsSearch
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, errors.New("Can't parse response")
+	}
+
+	return &result, nil
+}
+
+// SearchPaymentMethodsIter return an iterator over all the results of the search, starting at the offset of the search.
+func (c *Client) SearchPaymentMethodsIter(search RequestPaymentMethodsSearch) *Iterator[PaymentMethodSearchResult] {
+	fetch := func(ctx context.Context, offset, limit int) ([]PaymentMethodSearchResult, Paging, error) {
+		search.Offset, search.Limit = offset, limit
+		result, err := c.SearchPaymentMethods(ctx, search)
+		if err != nil {
+			return nil, Paging{}, err
+		}
+		return result.Results, result.Paging, nil
+	}
+	return newIterator(fetch, search.Offset, search.Limit)
+}
@@ -0,0 +1,98 @@
+package mercadopago_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestSearchPaymentMethods(t *testing.T) {
+
+	paymentMethods := loadPaymentMethods(t)
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if http.MethodGet != r.Method {
+			t.Fatalf("HTTP Method expected is %s but receive %s", http.MethodGet, r.Method)
+		}
+		urlPAth := "/v1/payment_methods/search"
+		if urlPAth != r.URL.Path {
+			t.Fatalf("Path URL expected is %s but receive %s", urlPAth, r.URL.Path)
+		}
+
+		q := r.URL.Query()
+		if q.Get("site_id") != "MLA" || q.Get("bins") != "450995,503175" {
+			t.Fatalf("Unexpected filters: %s", r.URL.RawQuery)
+		}
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		if limit == 0 {
+			limit = 30
+		}
+
+		result := mercadopago.PaymentMethodsSearch{
+			Paging: mercadopago.Paging{Total: len(paymentMethods), Limit: limit, Offset: offset},
+		}
+		for i := offset; i < offset+limit && i < len(paymentMethods); i++ {
+			result.Results = append(result.Results, mercadopago.PaymentMethodSearchResult{
+				PaymentMethod: paymentMethods[i],
+				SiteID:        "MLA",
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(result)
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	client := mercadopago.NewClient(server.URL+"/", accessToken)
+	search := mercadopago.RequestPaymentMethodsSearch{
+		SiteID: "MLA",
+		Bins:   []string{"450995", "503175"},
+		Limit:  5,
+	}
+
+	page, err := client.SearchPaymentMethods(context.Background(), search)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Results) != 5 || page.Paging.Total != len(paymentMethods) {
+		t.Fatalf("Expected a page of 5 results of %d but receive %d of %d", len(paymentMethods), len(page.Results), page.Paging.Total)
+	}
+	if page.Results[0].ID != paymentMethods[0].ID || page.Results[0].SiteID != "MLA" {
+		t.Fatalf("Unexpected first result: %+v", page.Results[0])
+	}
+
+	requests = 0
+	it := client.SearchPaymentMethodsIter(search)
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != len(paymentMethods) {
+		t.Fatalf("Expected %d payment methods but receive %d", len(paymentMethods), len(ids))
+	}
+	for i, id := range ids {
+		if paymentMethods[i].ID != id {
+			t.Fatalf("Expected payment method %s but receive %s", paymentMethods[i].ID, id)
+		}
+	}
+	if expected := (len(paymentMethods) + 4) / 5; requests != expected {
+		t.Fatalf("Expected %d requests but receive %d", expected, requests)
+	}
+	if it.Total() != len(paymentMethods) {
+		t.Fatalf("Expected total %d but receive %d", len(paymentMethods), it.Total())
+	}
+}
@@ -0,0 +1,194 @@
+package mercadopago
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// GetPayment return the payment with the ID.
+func (c *Client) GetPayment(ctx context.Context, id int64) (*Payment, error) {
+
+	url := fmt.Sprintf("%sv1/payments/%d", c.BaseURL, id)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		// The status of the response is kept even without a JSON body, so the callers that poll the payment
+		// can tell a server error from an error of the request.
+		var errRes ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err != nil {
+			errRes = ErrorResponse{Message: "unknown error"}
+		}
+		if errRes.Status == 0 {
+			errRes.Status = res.StatusCode
+		}
+		return nil, &errRes
+	}
+
+	var payment Payment
+	if err := json.NewDecoder(res.Body).Decode(&payment); err != nil {
+		return nil, errors.New("Can't parse response")
+	}
+
+	return &payment, nil
+}
+
+// Dates that can be used in the range of the payments search.
+const (
+	RangeDateCreated      = "date_created"
+	RangeDateApproved     = "date_approved"
+	RangeDateLastUpdated  = "date_last_updated"
+	RangeMoneyReleaseDate = "money_release_date"
+)
+
+// Orders of the payments search.
+const (
+	CriteriaAsc  = "asc"
+	CriteriaDesc = "desc"
+)
+
+// RequestPaymentsSearch are the filters to search payments, all of them are optional.
+// The date filter needs the range and both dates, a partial date filter is a validation error.
+type RequestPaymentsSearch struct {
+	Status            PaymentStatus
+	ExternalReference string
+	PayerEmail        string
+	Range             string
+	BeginDate         time.Time
+	EndDate           time.Time
+	Sort              string
+	Criteria          string
+	Limit             int
+	Offset            int
+}
+
+// Validate check that the date filter is complete, so the search is never made over all the dates by mistake.
+func (r RequestPaymentsSearch) Validate() error {
+	if r.Range == "" && r.BeginDate.IsZero() && r.EndDate.IsZero() {
+		return nil
+	}
+
+	var errs ValidationErrors
+	if r.Range == "" {
+		errs = append(errs, ValidationError{Field: "range", Message: "is required with the begin and end dates"})
+	}
+	if r.BeginDate.IsZero() {
+		errs = append(errs, ValidationError{Field: "begin_date", Message: "is required with the range"})
+	}
+	if r.EndDate.IsZero() {
+		errs = append(errs, ValidationError{Field: "end_date", Message: "is required with the range"})
+	}
+	if !r.BeginDate.IsZero() && !r.EndDate.IsZero() && r.EndDate.Before(r.BeginDate) {
+		errs = append(errs, ValidationError{Field: "end_date", Message: "must be after the begin date"})
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// searchDateLayout is the ISO 8601 format with milliseconds expected by the API.
+const searchDateLayout = "2006-01-02T15:04:05.000Z07:00"
+
+func (r RequestPaymentsSearch) query() url.Values {
+	q := url.Values{}
+	if r.Status != "" {
+		q.Set("status", string(r.Status))
+	}
+	if r.ExternalReference != "" {
+		q.Set("external_reference", r.ExternalReference)
+	}
+	if r.PayerEmail != "" {
+		q.Set("payer.email", r.PayerEmail)
+	}
+	if r.Range != "" {
+		q.Set("range", r.Range)
+		q.Set("begin_date", r.BeginDate.Format(searchDateLayout))
+		q.Set("end_date", r.EndDate.Format(searchDateLayout))
+	}
+	if r.Sort != "" {
+		q.Set("sort", r.Sort)
+	}
+	if r.Criteria != "" {
+		q.Set("criteria", r.Criteria)
+	}
+	if r.Limit > 0 {
+		q.Set("limit", strconv.Itoa(r.Limit))
+	}
+	if r.Offset > 0 {
+		q.Set("offset", strconv.Itoa(r.Offset))
+	}
+	return q
+}
+
+type PaymentsSearch struct {
+	Paging  Paging    `json:"paging"`
+	Results []Payment `json:"results"`
+}
+
+// SearchPayments search the payments with the filters, it return one page of results.
+func (c *Client) SearchPayments(ctx context.Context, search RequestPaymentsSearch) (*PaymentsSearch, error) {
+
+	if err := search.Validate(); err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%sv1/payments/search?%s", c.BaseURL, search.query().Encode())
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return nil, &errRes
+		}
+
+		return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	var result PaymentsSearch
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, errors.New("Can't parse response")
+	}
+
+	return &result, nil
+}
+
+// SearchPaymentsIter return an iterator over all the payments of the search, starting at the offset of the search.
+func (c *Client) SearchPaymentsIter(search RequestPaymentsSearch) *Iterator[Payment] {
+	fetch := func(ctx context.Context, offset, limit int) ([]Payment, Paging, error) {
+		search.Offset, search.Limit = offset, limit
+		result, err := c.SearchPayments(ctx, search)
+		if err != nil {
+			return nil, Paging{}, err
+		}
+		return result.Results, result.Paging, nil
+	}
+	return newIterator(fetch, search.Offset, search.Limit)
+}
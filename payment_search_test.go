@@ -0,0 +1,241 @@
+package mercadopago_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestGetPayment(t *testing.T) {
+
+	var response mercadopago.Payment
+	if err := json.NewDecoder(strings.NewReader(paymentResponse)).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+
+	tests := []struct {
+		name             string
+		id               int64
+		expectedResponse *mercadopago.Payment
+		expectedErr      *mercadopago.ErrorResponse
+	}{
+		{
+			name:             "Successful response",
+			id:               response.ID,
+			expectedResponse: &response,
+		},
+		{
+			name: "Not found",
+			id:   1,
+			expectedErr: &mercadopago.ErrorResponse{
+				Message: "Payment not found",
+				Errors:  "not_found",
+				Status:  http.StatusNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if http.MethodGet != r.Method {
+					t.Fatalf("HTTP Method expected is %s but receive %s", http.MethodGet, r.Method)
+				}
+
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				if r.URL.Path != "/v1/payments/20359978" {
+					w.WriteHeader(http.StatusNotFound)
+					data, _ := json.Marshal(tt.expectedErr)
+					_, _ = w.Write(data)
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(paymentResponse))
+			}))
+			defer server.Close()
+
+			client := mercadopago.NewClient(server.URL+"/", accessToken)
+			got, err := client.GetPayment(context.Background(), tt.id)
+			var errRes *mercadopago.ErrorResponse
+			if tt.expectedErr != nil && (!errors.As(err, &errRes) || errRes.Status != tt.expectedErr.Status) {
+				t.Fatalf("Receive error: %v | must be: %v", err, tt.expectedErr)
+			}
+			if !reflect.DeepEqual(got, tt.expectedResponse) {
+				t.Fatalf("Expected result is %v but receive %v", tt.expectedResponse, got)
+			}
+		})
+	}
+}
+
+func TestSearchPayments(t *testing.T) {
+
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+	total := 7
+	begin := time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 9, 30, 23, 59, 59, 0, time.UTC)
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if http.MethodGet != r.Method {
+			t.Fatalf("HTTP Method expected is %s but receive %s", http.MethodGet, r.Method)
+		}
+		urlPAth := "/v1/payments/search"
+		if urlPAth != r.URL.Path {
+			t.Fatalf("Path URL expected is %s but receive %s", urlPAth, r.URL.Path)
+		}
+
+		q := r.URL.Query()
+		expected := map[string]string{
+			"status":             "approved",
+			"external_reference": "order-1234",
+			"payer.email":        "test_user_123@testuser.com",
+			"range":              "date_created",
+			"begin_date":         "2023-09-01T00:00:00.000Z",
+			"end_date":           "2023-09-30T23:59:59.000Z",
+			"sort":               "date_created",
+			"criteria":           "desc",
+			"limit":              "3",
+		}
+		for key, value := range expected {
+			if q.Get(key) != value {
+				t.Fatalf("Expected %s=%s but receive %s", key, value, q.Get(key))
+			}
+		}
+
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		result := mercadopago.PaymentsSearch{
+			Paging: mercadopago.Paging{Total: total, Limit: limit, Offset: offset},
+		}
+		for i := offset; i < offset+limit && i < total; i++ {
+			result.Results = append(result.Results, mercadopago.Payment{ID: int64(i + 1), ExternalReference: "order-1234"})
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(result)
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	client := mercadopago.NewClient(server.URL+"/", accessToken)
+	search := mercadopago.RequestPaymentsSearch{
+		Status:            "approved",
+		ExternalReference: "order-1234",
+		PayerEmail:        "test_user_123@testuser.com",
+		Range:             mercadopago.RangeDateCreated,
+		BeginDate:         begin,
+		EndDate:           end,
+		Sort:              mercadopago.RangeDateCreated,
+		Criteria:          mercadopago.CriteriaDesc,
+		Limit:             3,
+	}
+
+	page, err := client.SearchPayments(context.Background(), search)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Results) != 3 || page.Paging.Total != total {
+		t.Fatalf("Expected a page of 3 payments of %d but receive %d of %d", total, len(page.Results), page.Paging.Total)
+	}
+
+	requests = 0
+	it := client.SearchPaymentsIter(search)
+	var ids []int64
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ids, []int64{1, 2, 3, 4, 5, 6, 7}) {
+		t.Fatalf("Unexpected payments: %v", ids)
+	}
+	if requests != 3 {
+		t.Fatalf("Expected 3 requests but receive %d", requests)
+	}
+}
+
+func TestRequestPaymentsSearchValidate(t *testing.T) {
+
+	begin := time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 9, 30, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		search         mercadopago.RequestPaymentsSearch
+		expectedFields []string
+	}{
+		{
+			name:   "Without date filter",
+			search: mercadopago.RequestPaymentsSearch{Status: "approved"},
+		},
+		{
+			name:   "Complete date filter",
+			search: mercadopago.RequestPaymentsSearch{Range: mercadopago.RangeDateCreated, BeginDate: begin, EndDate: end},
+		},
+		{
+			name:           "Range without dates",
+			search:         mercadopago.RequestPaymentsSearch{Range: mercadopago.RangeDateCreated},
+			expectedFields: []string{"begin_date", "end_date"},
+		},
+		{
+			name:           "Dates without range",
+			search:         mercadopago.RequestPaymentsSearch{BeginDate: begin, EndDate: end},
+			expectedFields: []string{"range"},
+		},
+		{
+			name:           "Only the end date",
+			search:         mercadopago.RequestPaymentsSearch{Range: mercadopago.RangeDateCreated, EndDate: end},
+			expectedFields: []string{"begin_date"},
+		},
+		{
+			name:           "End before begin",
+			search:         mercadopago.RequestPaymentsSearch{Range: mercadopago.RangeDateCreated, BeginDate: end, EndDate: begin},
+			expectedFields: []string{"end_date"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.search.Validate()
+			if len(tt.expectedFields) == 0 {
+				if err != nil {
+					t.Fatalf("Search should be valid but receive: %v", err)
+				}
+				return
+			}
+
+			var errs mercadopago.ValidationErrors
+			if !errors.As(err, &errs) || len(errs) != len(tt.expectedFields) {
+				t.Fatalf("Expected errors in %v but receive: %v", tt.expectedFields, err)
+			}
+			for _, field := range tt.expectedFields {
+				if errs.Field(field) == nil {
+					t.Fatalf("Expected an error in %s but receive: %v", field, err)
+				}
+			}
+		})
+	}
+
+	client := mercadopago.NewClient(mercadopago.BaseURL, "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344")
+	_, err := client.SearchPayments(context.Background(), mercadopago.RequestPaymentsSearch{BeginDate: begin})
+	var errs mercadopago.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected validation errors before the request but receive: %v", err)
+	}
+}
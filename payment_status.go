@@ -0,0 +1,230 @@
+package mercadopago
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// PaymentStatus is the status of a payment.
+type PaymentStatus string
+
+const (
+	PaymentStatusPending     PaymentStatus = "pending"
+	PaymentStatusApproved    PaymentStatus = "approved"
+	PaymentStatusAuthorized  PaymentStatus = "authorized"
+	PaymentStatusInProcess   PaymentStatus = "in_process"
+	PaymentStatusInMediation PaymentStatus = "in_mediation"
+	PaymentStatusRejected    PaymentStatus = "rejected"
+	PaymentStatusCancelled   PaymentStatus = "cancelled"
+	PaymentStatusRefunded    PaymentStatus = "refunded"
+	PaymentStatusChargedBack PaymentStatus = "charged_back"
+)
+
+// IsFinal report if the payment finished its processing, so the status won't change unless the payment is refunded
+// or charged back. The pending, authorized, in process and in mediation payments aren't final.
+func (s PaymentStatus) IsFinal() bool {
+	switch s {
+	case PaymentStatusApproved, PaymentStatusRejected, PaymentStatusCancelled, PaymentStatusRefunded, PaymentStatusChargedBack:
+		return true
+	}
+	return false
+}
+
+// IsApproved report if the payment was approved and the money credited.
+func (s PaymentStatus) IsApproved() bool {
+	return s == PaymentStatusApproved
+}
+
+// PaymentStatusDetail explain the status of a payment, like the reason of a rejection.
+type PaymentStatusDetail string
+
+const (
+	StatusDetailAccredited             PaymentStatusDetail = "accredited"
+	StatusDetailPartiallyRefunded      PaymentStatusDetail = "partially_refunded"
+	StatusDetailPendingCapture         PaymentStatusDetail = "pending_capture"
+	StatusDetailPendingContingency     PaymentStatusDetail = "pending_contingency"
+	StatusDetailPendingReviewManual    PaymentStatusDetail = "pending_review_manual"
+	StatusDetailPendingWaitingPayment  PaymentStatusDetail = "pending_waiting_payment"
+	StatusDetailPendingWaitingTransfer PaymentStatusDetail = "pending_waiting_transfer"
+	StatusDetailPendingChallenge       PaymentStatusDetail = "pending_challenge"
+	StatusDetailExpired                PaymentStatusDetail = "expired"
+	StatusDetailByCollector            PaymentStatusDetail = "by_collector"
+	StatusDetailByPayer                PaymentStatusDetail = "by_payer"
+	StatusDetailRefunded               PaymentStatusDetail = "refunded"
+	StatusDetailSettled                PaymentStatusDetail = "settled"
+	StatusDetailReimbursed             PaymentStatusDetail = "reimbursed"
+	StatusDetailInProcess              PaymentStatusDetail = "in_process"
+
+	StatusDetailBadFilledCardNumber   PaymentStatusDetail = "cc_rejected_bad_filled_card_number"
+	StatusDetailBadFilledDate         PaymentStatusDetail = "cc_rejected_bad_filled_date"
+	StatusDetailBadFilledOther        PaymentStatusDetail = "cc_rejected_bad_filled_other"
+	StatusDetailBadFilledSecurityCode PaymentStatusDetail = "cc_rejected_bad_filled_security_code"
+	StatusDetailBlacklist             PaymentStatusDetail = "cc_rejected_blacklist"
+	StatusDetailCallForAuthorize      PaymentStatusDetail = "cc_rejected_call_for_authorize"
+	StatusDetailCardDisabled          PaymentStatusDetail = "cc_rejected_card_disabled"
+	StatusDetailCardError             PaymentStatusDetail = "cc_rejected_card_error"
+	StatusDetailDuplicatedPayment     PaymentStatusDetail = "cc_rejected_duplicated_payment"
+	StatusDetailHighRisk              PaymentStatusDetail = "cc_rejected_high_risk"
+	StatusDetailInsufficientAmount    PaymentStatusDetail = "cc_rejected_insufficient_amount"
+	StatusDetailInvalidInstallments   PaymentStatusDetail = "cc_rejected_invalid_installments"
+	StatusDetailMaxAttempts           PaymentStatusDetail = "cc_rejected_max_attempts"
+	StatusDetailOtherReason           PaymentStatusDetail = "cc_rejected_other_reason"
+	StatusDetail3DSChallenge          PaymentStatusDetail = "cc_rejected_3ds_challenge"
+	StatusDetailAmountRateLimit       PaymentStatusDetail = "cc_amount_rate_limit_exceeded"
+	StatusDetailFraud                 PaymentStatusDetail = "cc_rejected_fraud"
+
+	StatusDetailRejectedByBank           PaymentStatusDetail = "rejected_by_bank"
+	StatusDetailRejectedInsufficientData PaymentStatusDetail = "rejected_insufficient_data"
+	StatusDetailRejectedByRegulations    PaymentStatusDetail = "rejected_by_regulations"
+	StatusDetailBankError                PaymentStatusDetail = "bank_error"
+)
+
+// IsCardRejection report if the detail is the reason of a rejected card payment.
+func (d PaymentStatusDetail) IsCardRejection() bool {
+	return strings.HasPrefix(string(d), "cc_rejected_") || d == StatusDetailAmountRateLimit
+}
+
+// IsRejection report if the detail is the reason of a rejected payment, with a card or other payment method.
+func (d PaymentStatusDetail) IsRejection() bool {
+	return d.IsCardRejection() || strings.HasPrefix(string(d), "rejected_") || d == StatusDetailBankError
+}
+
+// IsRetryableDecline report if the payer can try again after the rejection, with the same card after fixing the
+// data or authorizing the payment with the issuer. It follows the recommended action of the status detail catalog,
+// so the rejections that recommend another card, like fraud prevention or insufficient funds, aren't retryable.
+func (d PaymentStatusDetail) IsRetryableDecline() bool {
+	if !d.IsRejection() {
+		return false
+	}
+	switch d.Action() {
+	case ActionRetry, ActionCallIssuer:
+		return true
+	}
+	return false
+}
+
+// IsFinal report if the payment has a final status.
+func (p *Payment) IsFinal() bool {
+	return p.Status.IsFinal()
+}
+
+// IsApproved report if the payment was approved.
+func (p *Payment) IsApproved() bool {
+	return p.Status.IsApproved()
+}
+
+// IsRetryableDecline report if the payment was rejected with a reason that allow the payer to try again.
+func (p *Payment) IsRetryableDecline() bool {
+	return p.Status == PaymentStatusRejected && p.StatusDetail.IsRetryableDecline()
+}
+
+// Backoff is the time to wait between the requests of a polling, it starts with Initial and it's multiplied
+// by Multiplier after every request, up to Max. The zero fields use the values of DefaultPaymentStatusBackoff,
+// and a Multiplier lower than 1 is not allowed, so the polling never goes faster than Initial.
+type Backoff struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+}
+
+// DefaultPaymentStatusBackoff is the backoff used by WaitForPaymentStatus.
+var DefaultPaymentStatusBackoff = Backoff{
+	Initial:    2 * time.Second,
+	Max:        time.Minute,
+	Multiplier: 2,
+}
+
+// withDefaults return the backoff with the default values in the missing or invalid fields.
+func (b Backoff) withDefaults() Backoff {
+	if b.Initial <= 0 {
+		b.Initial = DefaultPaymentStatusBackoff.Initial
+	}
+	if b.Max <= 0 {
+		b.Max = DefaultPaymentStatusBackoff.Max
+	}
+	if b.Max < b.Initial {
+		b.Max = b.Initial
+	}
+	if b.Multiplier == 0 {
+		b.Multiplier = DefaultPaymentStatusBackoff.Multiplier
+	}
+	if b.Multiplier < 1 {
+		b.Multiplier = 1
+	}
+	return b
+}
+
+func (b Backoff) next(wait time.Duration) time.Duration {
+	wait = time.Duration(float64(wait) * b.Multiplier)
+	if wait > b.Max {
+		wait = b.Max
+	}
+	return wait
+}
+
+// isTransient report if the error of a request can be solved retrying it. Only the network errors, the server
+// errors and the rate limit are transient, the errors of the request, the responses that can't be parsed and the
+// errors of the client, like an endpoint not allowed with the public key, are not.
+func isTransient(err error) bool {
+	var errRes *ErrorResponse
+	if errors.As(err, &errRes) {
+		return errRes.Status >= http.StatusInternalServerError || errRes.Status == http.StatusTooManyRequests
+	}
+
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return false
+	}
+	var netErr net.Error
+	return errors.As(urlErr.Err, &netErr) || errors.Is(urlErr.Err, io.EOF) || errors.Is(urlErr.Err, io.ErrUnexpectedEOF)
+}
+
+// WaitForPaymentStatus poll the payment until the predicate return true or the payment has a final status, like
+// a payment in process waiting for the manual review. With a nil predicate it waits for the final status.
+// The last payment is returned, so when the payment finished without matching the predicate the caller must
+// check its status. The network errors, server errors and rate limits are retried, the other errors end the wait.
+// When the context ends the error of the context is returned with the last payment.
+func (c *Client) WaitForPaymentStatus(ctx context.Context, id int64, predicate func(*Payment) bool) (*Payment, error) {
+	return c.WaitForPaymentStatusWithBackoff(ctx, id, predicate, DefaultPaymentStatusBackoff)
+}
+
+// WaitForPaymentStatusWithBackoff is like WaitForPaymentStatus, with the backoff of the caller.
+func (c *Client) WaitForPaymentStatusWithBackoff(ctx context.Context, id int64, predicate func(*Payment) bool, backoff Backoff) (*Payment, error) {
+	if predicate == nil {
+		predicate = (*Payment).IsFinal
+	}
+
+	backoff = backoff.withDefaults()
+
+	var last *Payment
+	wait := backoff.Initial
+	for {
+		payment, err := c.GetPayment(ctx, id)
+		switch {
+		case err == nil:
+			if predicate(payment) || payment.IsFinal() {
+				return payment, nil
+			}
+			last = payment
+		case ctx.Err() != nil:
+			return last, ctx.Err()
+		case !isTransient(err):
+			return last, err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, ctx.Err()
+		case <-timer.C:
+		}
+		wait = backoff.next(wait)
+	}
+}
@@ -0,0 +1,253 @@
+package mercadopago_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestPaymentStatusClassification(t *testing.T) {
+
+	tests := []struct {
+		status     mercadopago.PaymentStatus
+		detail     mercadopago.PaymentStatusDetail
+		isFinal    bool
+		isApproved bool
+		retryable  bool
+	}{
+		{status: mercadopago.PaymentStatusApproved, detail: mercadopago.StatusDetailAccredited, isFinal: true, isApproved: true},
+		{status: mercadopago.PaymentStatusInProcess, detail: mercadopago.StatusDetailPendingContingency},
+		{status: mercadopago.PaymentStatusInProcess, detail: mercadopago.StatusDetailPendingReviewManual},
+		{status: mercadopago.PaymentStatusPending, detail: mercadopago.StatusDetailPendingWaitingPayment},
+		{status: mercadopago.PaymentStatusAuthorized, detail: mercadopago.StatusDetailPendingCapture},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailBadFilledSecurityCode, isFinal: true, retryable: true},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailCallForAuthorize, isFinal: true, retryable: true},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailCardDisabled, isFinal: true, retryable: true},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailInsufficientAmount, isFinal: true},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailOtherReason, isFinal: true},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailAmountRateLimit, isFinal: true},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailFraud, isFinal: true},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailRejectedByBank, isFinal: true},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailRejectedInsufficientData, isFinal: true, retryable: true},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailBankError, isFinal: true, retryable: true},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailHighRisk, isFinal: true},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailBlacklist, isFinal: true},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailDuplicatedPayment, isFinal: true},
+		{status: mercadopago.PaymentStatusRejected, detail: mercadopago.StatusDetailMaxAttempts, isFinal: true},
+		{status: mercadopago.PaymentStatusCancelled, detail: mercadopago.StatusDetailExpired, isFinal: true},
+		{status: mercadopago.PaymentStatusRefunded, detail: mercadopago.StatusDetailRefunded, isFinal: true},
+		{status: mercadopago.PaymentStatusChargedBack, detail: mercadopago.StatusDetailSettled, isFinal: true},
+		{status: mercadopago.PaymentStatusInMediation, detail: mercadopago.StatusDetailInProcess},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status)+"/"+string(tt.detail), func(t *testing.T) {
+			payment := mercadopago.Payment{Status: tt.status, StatusDetail: tt.detail}
+			if payment.IsFinal() != tt.isFinal {
+				t.Fatalf("Expected IsFinal %v", tt.isFinal)
+			}
+			if payment.IsApproved() != tt.isApproved {
+				t.Fatalf("Expected IsApproved %v", tt.isApproved)
+			}
+			if payment.IsRetryableDecline() != tt.retryable {
+				t.Fatalf("Expected IsRetryableDecline %v", tt.retryable)
+			}
+		})
+	}
+
+	if mercadopago.StatusDetailAccredited.IsCardRejection() || !mercadopago.StatusDetailHighRisk.IsCardRejection() {
+		t.Fatal("Unexpected card rejection classification")
+	}
+}
+
+func TestWaitForPaymentStatus(t *testing.T) {
+
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+	backoff := mercadopago.Backoff{Initial: time.Millisecond, Max: 4 * time.Millisecond, Multiplier: 2}
+
+	tests := []struct {
+		name           string
+		statuses       []mercadopago.PaymentStatus
+		predicate      func(*mercadopago.Payment) bool
+		failures       []int
+		backoff        *mercadopago.Backoff
+		timeout        time.Duration
+		expectedStatus mercadopago.PaymentStatus
+		expectedCalls  int32
+		expectedErr    error
+	}{
+		{
+			name:           "Wait for final status",
+			statuses:       []mercadopago.PaymentStatus{"in_process", "in_process", "approved"},
+			expectedStatus: mercadopago.PaymentStatusApproved,
+			expectedCalls:  3,
+		},
+		{
+			name:           "Predicate matched before final status",
+			statuses:       []mercadopago.PaymentStatus{"pending", "authorized", "approved"},
+			predicate:      func(p *mercadopago.Payment) bool { return p.Status == mercadopago.PaymentStatusAuthorized },
+			expectedStatus: mercadopago.PaymentStatusAuthorized,
+			expectedCalls:  2,
+		},
+		{
+			name:           "Final status without matching predicate",
+			statuses:       []mercadopago.PaymentStatus{"in_process", "rejected"},
+			predicate:      (*mercadopago.Payment).IsApproved,
+			expectedStatus: mercadopago.PaymentStatusRejected,
+			expectedCalls:  2,
+		},
+		{
+			name:           "Context ends",
+			statuses:       []mercadopago.PaymentStatus{"in_process"},
+			timeout:        20 * time.Millisecond,
+			expectedStatus: mercadopago.PaymentStatusInProcess,
+			expectedErr:    context.DeadlineExceeded,
+		},
+		{
+			name:           "Zero backoff use the default",
+			statuses:       []mercadopago.PaymentStatus{"in_process"},
+			backoff:        &mercadopago.Backoff{},
+			timeout:        50 * time.Millisecond,
+			expectedStatus: mercadopago.PaymentStatusInProcess,
+			expectedCalls:  1,
+			expectedErr:    context.DeadlineExceeded,
+		},
+		{
+			name:           "Server and rate limit errors are retried",
+			failures:       []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusTooManyRequests},
+			statuses:       []mercadopago.PaymentStatus{"approved"},
+			expectedStatus: mercadopago.PaymentStatusApproved,
+			expectedCalls:  4,
+		},
+		{
+			name:           "Network errors are retried",
+			failures:       []int{0},
+			statuses:       []mercadopago.PaymentStatus{"approved"},
+			expectedStatus: mercadopago.PaymentStatusApproved,
+			expectedCalls:  2,
+		},
+		{
+			name:          "Unauthorized without JSON body ends the wait",
+			failures:      []int{http.StatusUnauthorized},
+			expectedCalls: 1,
+			expectedErr:   &mercadopago.ErrorResponse{Status: http.StatusUnauthorized},
+		},
+		{
+			name:          "Not found ends the wait",
+			failures:      []int{http.StatusNotFound},
+			expectedCalls: 1,
+			expectedErr:   &mercadopago.ErrorResponse{Status: http.StatusNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					t.Fatalf("HTTP Method expected is %s but receive %s", http.MethodGet, r.Method)
+				}
+				urlPAth := "/v1/payments/20359978"
+				if urlPAth != r.URL.Path {
+					t.Fatalf("Path URL expected is %s but receive %s", urlPAth, r.URL.Path)
+				}
+
+				call := int(atomic.AddInt32(&calls, 1))
+				if call <= len(tt.failures) {
+					status := tt.failures[call-1]
+					if status == 0 {
+						conn, _, err := w.(http.Hijacker).Hijack()
+						if err != nil {
+							t.Fatal(err)
+						}
+						conn.Close()
+						return
+					}
+					w.WriteHeader(status)
+					if status == http.StatusNotFound {
+						_, _ = w.Write([]byte(`{"message": "Payment not found", "error": "not_found", "status": 404, "cause": []}`))
+					} else {
+						_, _ = w.Write([]byte(`<html>` + http.StatusText(status) + `</html>`))
+					}
+					return
+				}
+				call -= len(tt.failures)
+				if call > len(tt.statuses) {
+					call = len(tt.statuses)
+				}
+				payment := mercadopago.Payment{ID: 20359978, Status: tt.statuses[call-1]}
+
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				w.WriteHeader(http.StatusOK)
+				data, _ := json.Marshal(payment)
+				_, _ = w.Write(data)
+			}))
+			defer server.Close()
+
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.timeout)
+				defer cancel()
+			}
+
+			waitBackoff := backoff
+			if tt.backoff != nil {
+				waitBackoff = *tt.backoff
+			}
+
+			client := mercadopago.NewClient(server.URL+"/", accessToken)
+			got, err := client.WaitForPaymentStatusWithBackoff(ctx, 20359978, tt.predicate, waitBackoff)
+			var errRes *mercadopago.ErrorResponse
+			if expected, ok := tt.expectedErr.(*mercadopago.ErrorResponse); ok {
+				if !errors.As(err, &errRes) || errRes.Status != expected.Status {
+					t.Fatalf("Receive error: %v | must be: %v", err, tt.expectedErr)
+				}
+			} else if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Receive error: %v | must be: %v", err, tt.expectedErr)
+			}
+			if tt.expectedStatus == "" {
+				if got != nil {
+					t.Fatalf("Expected no payment but receive %+v", got)
+				}
+			} else if got == nil || got.Status != tt.expectedStatus {
+				t.Fatalf("Expected status %s but receive %+v", tt.expectedStatus, got)
+			}
+			if tt.expectedCalls > 0 && atomic.LoadInt32(&calls) != tt.expectedCalls {
+				t.Fatalf("Expected %d requests but receive %d", tt.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestWaitForPaymentStatusPublicKey(t *testing.T) {
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	client := mercadopago.NewPublicKeyClient(server.URL+"/", "TEST-b3d5b663-664a-4e8f-b759-de5d7c12ef8f")
+	backoff := mercadopago.Backoff{Initial: time.Millisecond, Max: time.Millisecond, Multiplier: 1}
+	_, err := client.WaitForPaymentStatusWithBackoff(ctx, 20359978, nil, backoff)
+	if !errors.Is(err, mercadopago.ErrAccessTokenRequired) {
+		t.Fatalf("Receive error: %v | must be: %v", err, mercadopago.ErrAccessTokenRequired)
+	}
+	if calls != 0 {
+		t.Fatalf("Expected no requests but receive %d", calls)
+	}
+}
@@ -0,0 +1,268 @@
+package mercadopago_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+const paymentResponse = `
+{
+  "id": 20359978,
+  "date_created": "2023-09-01T10:00:00.000-04:00",
+  "date_approved": "2023-09-01T10:00:01.000-04:00",
+  "date_last_updated": "2023-09-01T10:00:01.000-04:00",
+  "money_release_date": "2023-09-15T10:00:01.000-04:00",
+  "operation_type": "regular_payment",
+  "issuer_id": "310",
+  "payment_method_id": "visa",
+  "payment_type_id": "credit_card",
+  "status": "approved",
+  "status_detail": "accredited",
+  "currency_id": "ARS",
+  "description": "Blue shirt",
+  "live_mode": false,
+  "authorization_code": "123456",
+  "transaction_amount": 1000,
+  "transaction_amount_refunded": 0,
+  "coupon_amount": 0,
+  "installments": 3,
+  "transaction_details": {
+    "net_received_amount": 1131.4,
+    "total_paid_amount": 1200,
+    "installment_amount": 400,
+    "overpaid_amount": 0
+  },
+  "fee_details": [
+    {"type": "mercadopago_fee", "fee_payer": "collector", "amount": 68.6},
+    {"type": "financing_fee", "fee_payer": "payer", "amount": 200}
+  ],
+  "captured": true,
+  "binary_mode": false,
+  "statement_descriptor": "MYSTORE",
+  "external_reference": "order-1234",
+  "notification_url": "https://example.com/notifications",
+  "metadata": {"order_id": "1234"},
+  "payer": {
+    "type": "customer",
+    "id": "123456789",
+    "email": "test_user_123@testuser.com",
+    "identification": {"type": "DNI", "number": "12345678"}
+  },
+  "card": {
+    "id": null,
+    "first_six_digits": "450995",
+    "last_four_digits": "3704",
+    "expiration_month": 11,
+    "expiration_year": 2030,
+    "cardholder": {"name": "APRO", "identification": {"type": "DNI", "number": "12345678"}}
+  },
+  "additional_info": {
+    "items": [{"id": "shirt-1", "title": "Blue shirt", "quantity": 1, "unit_price": 1000}]
+  }
+}
+`
+
+func TestCreatePayment(t *testing.T) {
+
+	var response mercadopago.Payment
+	if err := json.NewDecoder(strings.NewReader(paymentResponse)).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+	capture := true
+
+	validPayment := mercadopago.PaymentRequest{
+		TransactionAmount: 1000,
+		Token:             "234bc93745ac08281fdc7dba4aa4567b",
+		Description:       "Blue shirt",
+		Installments:      3,
+		PaymentMethodID:   "visa",
+		IssuerID:          "310",
+		Payer: mercadopago.PaymentPayer{
+			Email:          "test_user_123@testuser.com",
+			Identification: &mercadopago.Identification{Type: "DNI", Number: "12345678"},
+		},
+		Capture:             &capture,
+		StatementDescriptor: "MYSTORE",
+		ExternalReference:   "order-1234",
+		Metadata:            map[string]interface{}{"order_id": "1234"},
+		NotificationURL:     "https://example.com/notifications",
+		AdditionalInfo: &mercadopago.PaymentAdditionalInfo{
+			Items: []mercadopago.Item{{ID: "shirt-1", Title: "Blue shirt", Quantity: 1, UnitPrice: 1000}},
+			Shipments: &mercadopago.AdditionalInfoShipments{
+				ReceiverAddress: &mercadopago.ReceiverAddress{ZipCode: "1414", StreetName: "Av. Corrientes", StreetNumber: "1234"},
+			},
+		},
+		IdempotencyKey: "order-1234-payment",
+	}
+
+	tests := []struct {
+		name             string
+		request          mercadopago.PaymentRequest
+		expectedResponse *mercadopago.Payment
+		expectedErr      error
+	}{
+		{
+			name:             "Successful response",
+			request:          validPayment,
+			expectedResponse: &response,
+		},
+		{
+			name:        "Without idempotency key",
+			request:     func() mercadopago.PaymentRequest { p := validPayment; p.IdempotencyKey = ""; return p }(),
+			expectedErr: mercadopago.ValidationErrors{},
+		},
+		{
+			name:        "Invalid request",
+			request:     mercadopago.PaymentRequest{Token: "234bc93745ac08281fdc7dba4aa4567b"},
+			expectedErr: mercadopago.ValidationErrors{},
+		},
+		{
+			name:    "Rejected by the API",
+			request: func() mercadopago.PaymentRequest { p := validPayment; p.Token = "invalid"; return p }(),
+			expectedErr: &mercadopago.ErrorResponse{
+				Message: "Invalid card_token_id",
+				Errors:  "bad_request",
+				Status:  http.StatusBadRequest,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if http.MethodPost != r.Method {
+					t.Fatalf("HTTP Method expected is %s but receive %s", http.MethodPost, r.Method)
+				}
+				urlPAth := "/v1/payments"
+				if urlPAth != r.URL.Path {
+					t.Fatalf("Path URL expected is %s but receive %s", urlPAth, r.URL.Path)
+				}
+
+				key := r.Header.Get("X-Idempotency-Key")
+				if key != tt.request.IdempotencyKey {
+					t.Fatalf("Unexpected idempotency key: %q", key)
+				}
+
+				var reqPayment mercadopago.PaymentRequest
+				if err := json.NewDecoder(r.Body).Decode(&reqPayment); err != nil {
+					t.Fatal(err)
+				}
+				reqPayment.IdempotencyKey = tt.request.IdempotencyKey
+
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				if reqPayment.Token != validPayment.Token {
+					w.WriteHeader(http.StatusBadRequest)
+					data, _ := json.Marshal(tt.expectedErr)
+					_, _ = w.Write(data)
+					return
+				}
+				if !reflect.DeepEqual(reqPayment, tt.request) {
+					t.Fatalf("Expected request is %+v but receive %+v", tt.request, reqPayment)
+				}
+
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(paymentResponse))
+			}))
+			defer server.Close()
+
+			client := mercadopago.NewClient(server.URL+"/", accessToken)
+			got, err := client.CreatePayment(context.Background(), tt.request)
+
+			var errRes *mercadopago.ErrorResponse
+			var errs mercadopago.ValidationErrors
+			switch tt.expectedErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatal(err)
+				}
+			case *mercadopago.ErrorResponse:
+				if !errors.As(err, &errRes) || errRes.Message != tt.expectedErr.(*mercadopago.ErrorResponse).Message {
+					t.Fatalf("Receive error: %v | must be: %v", err, tt.expectedErr)
+				}
+			case mercadopago.ValidationErrors:
+				if !errors.As(err, &errs) {
+					t.Fatalf("Expected validation errors but receive: %v", err)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.expectedResponse) {
+				t.Fatalf("Expected result is %v but receive %v", tt.expectedResponse, got)
+			}
+		})
+	}
+}
+
+func TestPaymentPayerValidate(t *testing.T) {
+
+	payer := mercadopago.PaymentPayer{
+		Email:          "test_user_123@testuser.com",
+		Identification: &mercadopago.Identification{Type: "CPF", Number: "19119119101"},
+	}
+
+	var errs mercadopago.ValidationErrors
+	if err := payer.Validate("MLB"); !errors.As(err, &errs) || errs.Field("payer.identification.number") == nil {
+		t.Fatalf("Expected an error in the payer identification number but receive: %v", err)
+	}
+
+	payer.Identification.Number = "19119119100"
+	if err := payer.Validate("MLB"); err != nil {
+		t.Fatalf("Payer should be valid but receive: %s", err)
+	}
+
+	payer.Identification = &mercadopago.Identification{Type: "RFC", Number: "GODE561231GR8"}
+	if err := payer.Validate("MLM"); err != nil {
+		t.Fatalf("Payer with RFC should be valid but receive: %s", err)
+	}
+
+	payer.Identification = nil
+	if err := payer.Validate("MLM"); err != nil {
+		t.Fatalf("Payer without identification should be valid but receive: %s", err)
+	}
+}
+
+func TestCreatePaymentLiveModeMismatch(t *testing.T) {
+
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+	key, err := mercadopago.NewIdempotencyKey()
+	if err != nil || len(key) != 32 {
+		t.Fatalf("Unexpected idempotency key %q: %v", key, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Idempotency-Key") != key {
+			t.Fatalf("Unexpected idempotency key: %q", r.Header.Get("X-Idempotency-Key"))
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(strings.Replace(paymentResponse, `"live_mode": false`, `"live_mode": true`, 1)))
+	}))
+	defer server.Close()
+
+	client := mercadopago.NewClient(server.URL+"/", accessToken)
+	if err := client.SetEnvironment(mercadopago.EnvironmentSandbox); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := client.CreatePayment(context.Background(), mercadopago.PaymentRequest{
+		TransactionAmount: 1000,
+		PaymentMethodID:   "rapipago",
+		Payer:             mercadopago.PaymentPayer{Email: "test_user_123@testuser.com"},
+		IdempotencyKey:    key,
+	})
+	if !errors.Is(err, mercadopago.ErrEnvironmentMismatch) {
+		t.Fatalf("Receive error: %v | must be: %v", err, mercadopago.ErrEnvironmentMismatch)
+	}
+	if got == nil || got.ID != 20359978 {
+		t.Fatalf("Expected the created payment with the error but receive %v", got)
+	}
+}
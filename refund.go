@@ -0,0 +1,191 @@
+package mercadopago
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type Refund struct {
+	ID                    int64                  `json:"id"`
+	PaymentID             int64                  `json:"payment_id"`
+	Amount                Money                  `json:"amount"`
+	Metadata              map[string]interface{} `json:"metadata"`
+	Source                RefundSource           `json:"source"`
+	DateCreated           string                 `json:"date_created"`
+	UniqueSequenceNumber  string                 `json:"unique_sequence_number"`
+	RefundMode            string                 `json:"refund_mode"`
+	AdjustmentAmount      Money                  `json:"adjustment_amount"`
+	Status                string                 `json:"status"`
+	Reason                string                 `json:"reason"`
+	AmountRefundedToPayer Money                  `json:"amount_refunded_to_payer"`
+}
+
+type RefundSource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// counts report if the refund reduce the amount that can be refunded, the rejected and cancelled refunds don't.
+func (r Refund) counts() bool {
+	return r.Status != "rejected" && r.Status != "cancelled"
+}
+
+// RemainingRefundable return the amount of the payment that can still be refunded, after the existing refunds.
+func RemainingRefundable(payment Payment, refunds []Refund) Money {
+	remaining := NewMoney(payment.TransactionAmount)
+	for _, refund := range refunds {
+		if refund.counts() {
+			remaining -= refund.Amount
+		}
+	}
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// RemainingRefundable return the amount of the payment that can still be refunded, using the refunds of the payment.
+func (p Payment) RemainingRefundable() Money {
+	return RemainingRefundable(p, p.Refunds)
+}
+
+// RefundPayment refund the payment, with a nil amount the refund is total and with an amount it's partial.
+// A partial refund is rejected before calling the API when it's greater than the remaining refundable amount.
+// The idempotency key is required and the same key must be sent in every retry of the refund, so retrying after
+// a timeout never refund the payment twice. NewIdempotencyKey can generate it, to be stored with the return.
+func (c *Client) RefundPayment(ctx context.Context, paymentID int64, amount *Money, idempotencyKey string) (*Refund, error) {
+
+	if idempotencyKey == "" {
+		return nil, ValidationErrors{{Field: "idempotency_key", Message: "is required"}}
+	}
+	if err := c.checkEnvironment(); err != nil {
+		return nil, err
+	}
+
+	if amount != nil {
+		if *amount <= 0 {
+			return nil, fmt.Errorf("refund amount must be greater than zero")
+		}
+		payment, err := c.GetPayment(ctx, paymentID)
+		if err != nil {
+			return nil, err
+		}
+		if remaining := payment.RemainingRefundable(); *amount > remaining {
+			return nil, fmt.Errorf("%w: amount %s, remaining %s", ErrRefundExceedsRemaining, amount, remaining)
+		}
+	}
+
+	data := struct {
+		Amount *Money `json:"amount,omitempty"`
+	}{
+		Amount: amount,
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	url := fmt.Sprintf("%sv1/payments/%d/refunds", c.BaseURL, paymentID)
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Idempotency-Key", idempotencyKey)
+
+	req = req.WithContext(ctx)
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return nil, &errRes
+		}
+
+		return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	var refund Refund
+	if err := json.NewDecoder(res.Body).Decode(&refund); err != nil {
+		return nil, errors.New("Can't parse response")
+	}
+
+	return &refund, nil
+}
+
+// ListRefunds return the refunds of the payment.
+func (c *Client) ListRefunds(ctx context.Context, paymentID int64) ([]Refund, error) {
+
+	url := fmt.Sprintf("%sv1/payments/%d/refunds", c.BaseURL, paymentID)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return nil, &errRes
+		}
+
+		return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	var refunds []Refund
+	if err := json.NewDecoder(res.Body).Decode(&refunds); err != nil {
+		return nil, errors.New("Can't parse response")
+	}
+
+	return refunds, nil
+}
+
+// GetRefund return a refund of the payment.
+func (c *Client) GetRefund(ctx context.Context, paymentID, refundID int64) (*Refund, error) {
+
+	url := fmt.Sprintf("%sv1/payments/%d/refunds/%d", c.BaseURL, paymentID, refundID)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return nil, &errRes
+		}
+
+		return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	var refund Refund
+	if err := json.NewDecoder(res.Body).Decode(&refund); err != nil {
+		return nil, errors.New("Can't parse response")
+	}
+
+	return &refund, nil
+}
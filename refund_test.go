@@ -0,0 +1,284 @@
+package mercadopago_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestMoney(t *testing.T) {
+
+	tests := []struct {
+		amount   float64
+		expected mercadopago.Money
+		text     string
+	}{
+		{amount: 0.1 + 0.2, expected: 30, text: "0.30"},
+		{amount: 1131.4, expected: 113140, text: "1131.40"},
+		{amount: 19.999, expected: 2000, text: "20.00"},
+		{amount: 0, expected: 0, text: "0.00"},
+	}
+
+	for _, tt := range tests {
+		got := mercadopago.NewMoney(tt.amount)
+		if got != tt.expected {
+			t.Fatalf("Expected %d but receive %d", tt.expected, got)
+		}
+		if got.String() != tt.text {
+			t.Fatalf("Expected %s but receive %s", tt.text, got.String())
+		}
+
+		data, err := json.Marshal(got)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded mercadopago.Money
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if decoded != got {
+			t.Fatalf("Expected %d after JSON round trip but receive %d (%s)", got, decoded, data)
+		}
+	}
+}
+
+func TestRemainingRefundable(t *testing.T) {
+
+	payment := mercadopago.Payment{TransactionAmount: 100.3}
+
+	tests := []struct {
+		name     string
+		refunds  []mercadopago.Refund
+		expected mercadopago.Money
+	}{
+		{
+			name:     "Without refunds",
+			expected: 10030,
+		},
+		{
+			name: "Partial refunds",
+			refunds: []mercadopago.Refund{
+				{Amount: mercadopago.NewMoney(0.1), Status: "approved"},
+				{Amount: mercadopago.NewMoney(0.2), Status: "in_process"},
+			},
+			expected: 10000,
+		},
+		{
+			name: "Rejected and cancelled refunds don't count",
+			refunds: []mercadopago.Refund{
+				{Amount: mercadopago.NewMoney(50), Status: "rejected"},
+				{Amount: mercadopago.NewMoney(50), Status: "cancelled"},
+			},
+			expected: 10030,
+		},
+		{
+			name: "Totally refunded",
+			refunds: []mercadopago.Refund{
+				{Amount: mercadopago.NewMoney(100.3), Status: "approved"},
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mercadopago.RemainingRefundable(payment, tt.refunds)
+			if got != tt.expected {
+				t.Fatalf("Expected %s but receive %s", tt.expected, got)
+			}
+
+			payment.Refunds = tt.refunds
+			if got := payment.RemainingRefundable(); got != tt.expected {
+				t.Fatalf("Expected %s but receive %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRefundPayment(t *testing.T) {
+
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+	amount := func(m float64) *mercadopago.Money {
+		money := mercadopago.NewMoney(m)
+		return &money
+	}
+
+	tests := []struct {
+		name           string
+		amount         *mercadopago.Money
+		idempotencyKey string
+		expectedBody   map[string]interface{}
+		expectedErr    error
+	}{
+		{
+			name:           "Total refund",
+			idempotencyKey: "refund-20359978-1",
+			expectedBody:   map[string]interface{}{},
+		},
+		{
+			name:           "Partial refund",
+			amount:         amount(60.5),
+			idempotencyKey: "refund-20359978-2",
+			expectedBody:   map[string]interface{}{"amount": 60.5},
+		},
+		{
+			name:           "Partial refund of the remaining amount",
+			amount:         amount(70),
+			idempotencyKey: "refund-20359978-3",
+			expectedBody:   map[string]interface{}{"amount": 70.0},
+		},
+		{
+			name:        "Without idempotency key",
+			amount:      amount(10),
+			expectedErr: mercadopago.ValidationErrors{{Field: "idempotency_key", Message: "is required"}},
+		},
+		{
+			name:           "Refund more than remaining",
+			amount:         amount(70.01),
+			idempotencyKey: "refund-20359978-4",
+			expectedErr:    mercadopago.ErrRefundExceedsRemaining,
+		},
+		{
+			name:           "Refund zero amount",
+			amount:         amount(0),
+			idempotencyKey: "refund-20359978-5",
+			expectedErr:    errors.New("refund amount must be greater than zero"),
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			payment := mercadopago.Payment{
+				ID:                20359978,
+				Status:            "approved",
+				TransactionAmount: 100,
+				Refunds:           []mercadopago.Refund{{ID: 1, Amount: mercadopago.NewMoney(30), Status: "approved"}},
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var response interface{}
+				switch r.Method {
+				case http.MethodGet:
+					urlPAth := "/v1/payments/20359978"
+					if urlPAth != r.URL.Path {
+						t.Fatalf("Path URL expected is %s but receive %s", urlPAth, r.URL.Path)
+					}
+					response = payment
+				case http.MethodPost:
+					urlPAth := "/v1/payments/20359978/refunds"
+					if urlPAth != r.URL.Path {
+						t.Fatalf("Path URL expected is %s but receive %s", urlPAth, r.URL.Path)
+					}
+					if key := r.Header.Get("X-Idempotency-Key"); key != tt.idempotencyKey {
+						t.Fatalf("Expected idempotency key %s but receive %s", tt.idempotencyKey, key)
+					}
+					var body map[string]interface{}
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Fatal(err)
+					}
+					if len(body) != len(tt.expectedBody) {
+						t.Fatalf("Expected body %v but receive %v", tt.expectedBody, body)
+					}
+					for key, value := range tt.expectedBody {
+						if body[key] != value {
+							t.Fatalf("Expected body %v but receive %v", tt.expectedBody, body)
+						}
+					}
+					refund := mercadopago.Refund{ID: 2, PaymentID: payment.ID, Status: "approved"}
+					if tt.amount != nil {
+						refund.Amount = *tt.amount
+					} else {
+						refund.Amount = payment.RemainingRefundable()
+					}
+					response = refund
+				default:
+					t.Fatalf("Unexpected HTTP Method %s", r.Method)
+				}
+
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				w.WriteHeader(http.StatusCreated)
+				data, _ := json.Marshal(response)
+				_, _ = w.Write(data)
+			}))
+			defer server.Close()
+
+			client := mercadopago.NewClient(server.URL+"/", accessToken)
+			got, err := client.RefundPayment(context.Background(), payment.ID, tt.amount, tt.idempotencyKey)
+			if tt.expectedErr != nil {
+				if err == nil || (!errors.Is(err, tt.expectedErr) && err.Error() != tt.expectedErr.Error()) {
+					t.Fatalf("Receive error: %v | must be: %v", err, tt.expectedErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.PaymentID != payment.ID {
+				t.Fatalf("Expected payment ID %d but receive %d", payment.ID, got.PaymentID)
+			}
+			if tt.amount == nil && got.Amount != mercadopago.NewMoney(70) {
+				t.Fatalf("Expected a total refund of 70.00 but receive %s", got.Amount)
+			}
+		})
+	}
+}
+
+func TestListAndGetRefunds(t *testing.T) {
+
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+	refundsResponse := `[
+		{"id": 1, "payment_id": 20359978, "amount": 30.5, "status": "approved", "source": {"id": "470823344", "name": "Test Test", "type": "collector"}},
+		{"id": 2, "payment_id": 20359978, "amount": 10, "status": "approved", "source": {"id": "470823344", "name": "Test Test", "type": "collector"}}
+	]`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("HTTP Method expected is %s but receive %s", http.MethodGet, r.Method)
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		switch r.URL.Path {
+		case "/v1/payments/20359978/refunds":
+			_, _ = w.Write([]byte(refundsResponse))
+		case "/v1/payments/20359978/refunds/2":
+			_, _ = w.Write([]byte(`{"id": 2, "payment_id": 20359978, "amount": 10, "status": "approved"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Refund not found", "error": "not_found", "status": 404, "cause": []}`))
+		}
+	}))
+	defer server.Close()
+
+	client := mercadopago.NewClient(server.URL+"/", accessToken)
+
+	refunds, err := client.ListRefunds(context.Background(), 20359978)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refunds) != 2 {
+		t.Fatalf("Expected 2 refunds but receive %d", len(refunds))
+	}
+	if refunds[0].Amount != 3050 || refunds[0].Source.Type != "collector" {
+		t.Fatalf("Unexpected refund %+v", refunds[0])
+	}
+
+	refund, err := client.GetRefund(context.Background(), 20359978, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refund.ID != 2 || refund.Amount != 1000 {
+		t.Fatalf("Unexpected refund %+v", refund)
+	}
+
+	_, err = client.GetRefund(context.Background(), 20359978, 3)
+	var errRes *mercadopago.ErrorResponse
+	if !errors.As(err, &errRes) || errRes.Status != http.StatusNotFound {
+		t.Fatalf("Expected a not found error but receive %v", err)
+	}
+}
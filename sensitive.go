@@ -0,0 +1,120 @@
+package mercadopago
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const redacted = "[REDACTED]"
+
+// SensitiveString hold card data like the card number or the security code. It's always redacted when it's
+// printed, logged or marshaled, only the card token request encoders and the card validation read the raw value.
+// The value is stored in a byte slice shared by all the copies, so Wipe clear it everywhere.
+type SensitiveString struct {
+	buf []byte
+}
+
+// NewSensitiveString copy the value into a new SensitiveString.
+func NewSensitiveString(s string) SensitiveString {
+	return SensitiveString{buf: []byte(s)}
+}
+
+// Reveal return a copy of the raw value. The copy is a string, so it can't be wiped, use it carefully.
+func (s SensitiveString) Reveal() string {
+	return string(s.buf)
+}
+
+// Len return the length of the raw value.
+func (s SensitiveString) Len() int {
+	return len(s.buf)
+}
+
+// IsZero report if the value is empty.
+func (s SensitiveString) IsZero() bool {
+	return len(s.buf) == 0
+}
+
+// Wipe overwrite the raw value with zeros.
+func (s SensitiveString) Wipe() {
+	wipe(s.buf)
+}
+
+func (s SensitiveString) String() string {
+	return redacted
+}
+
+func (s SensitiveString) GoString() string {
+	return redacted
+}
+
+// Format implement fmt.Formatter so any verb, including %v, %+v, %#v, %s and %q, print the redacted value.
+func (s SensitiveString) Format(f fmt.State, verb rune) {
+	if verb == 'q' {
+		_, _ = fmt.Fprintf(f, "%q", redacted)
+		return
+	}
+	_, _ = f.Write([]byte(redacted))
+}
+
+func (s SensitiveString) MarshalText() ([]byte, error) {
+	return []byte(redacted), nil
+}
+
+func (s SensitiveString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redacted)
+}
+
+func (s *SensitiveString) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	s.buf = []byte(value)
+	return nil
+}
+
+// isDigits report if the raw value has only digits, without copying it.
+func (s SensitiveString) isDigits() bool {
+	return isDigits(s.buf)
+}
+
+// jsonLen return the length of the raw value encoded as a JSON string by appendJSON.
+func (s SensitiveString) jsonLen() int {
+	n := 2
+	for _, b := range s.buf {
+		switch {
+		case b == '"' || b == '\\':
+			n += 2
+		case b < 0x20:
+			n += 6
+		default:
+			n++
+		}
+	}
+	return n
+}
+
+// appendJSON append the raw value encoded as a JSON string to dst. The caller must give a dst with room for
+// jsonLen more bytes, so the raw value is never left in a buffer dropped by append.
+func (s SensitiveString) appendJSON(dst []byte) []byte {
+	const hex = "0123456789abcdef"
+
+	dst = append(dst, '"')
+	for _, b := range s.buf {
+		switch {
+		case b == '"' || b == '\\':
+			dst = append(dst, '\\', b)
+		case b < 0x20:
+			dst = append(dst, '\\', 'u', '0', '0', hex[b>>4], hex[b&0xF])
+		default:
+			dst = append(dst, b)
+		}
+	}
+	return append(dst, '"')
+}
+
+func wipe(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
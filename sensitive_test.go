@@ -0,0 +1,135 @@
+package mercadopago_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestSensitiveStringRedaction(t *testing.T) {
+
+	pan := "5031755734530604"
+	card := mercadopago.RequestCardToken{
+		CardNumber:      mercadopago.NewSensitiveString(pan),
+		ExpirationMonth: 11,
+		ExpirationYear:  2030,
+		SecurityCode:    mercadopago.NewSensitiveString("123"),
+	}
+
+	pretty, err := mercadopago.PrettyStruct(card)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := mercadopago.RequestSavedCardToken{CardID: "9053893640", SecurityCode: mercadopago.NewSensitiveString("123")}
+	prettySaved, err := mercadopago.PrettyStruct(saved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := card.CardNumber.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outputs := []string{
+		fmt.Sprint(card),
+		fmt.Sprintf("%v", card),
+		fmt.Sprintf("%+v", card),
+		fmt.Sprintf("%#v", card),
+		fmt.Sprintf("%s", card.CardNumber),
+		fmt.Sprintf("%q", card.CardNumber),
+		fmt.Sprintf("%d", card.CardNumber),
+		card.CardNumber.String(),
+		card.CardNumber.GoString(),
+		string(text),
+		pretty,
+		fmt.Sprintf("%+v", saved),
+		prettySaved,
+	}
+
+	for _, out := range outputs {
+		if strings.Contains(out, pan) || strings.Contains(out, "123\"") {
+			t.Fatalf("Card data leaked in output: %s", out)
+		}
+		if !strings.Contains(out, "[REDACTED]") {
+			t.Fatalf("Expected redacted output but receive: %s", out)
+		}
+	}
+}
+
+func TestGetCardTokenWipeCardData(t *testing.T) {
+
+	pan := "5031755734530604"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqCard mercadopago.RequestCardToken
+		if err := json.NewDecoder(r.Body).Decode(&reqCard); err != nil {
+			t.Fatal(err)
+		}
+		if reqCard.CardNumber.Reveal() != pan || reqCard.SecurityCode.Reveal() != "123" {
+			t.Fatal("The request must have the raw card number and security code")
+		}
+		if reqCard.ExpirationMonth != 11 || reqCard.ExpirationYear != 2030 || reqCard.Cardholder.Name != `APRO "Test"` || !reqCard.RequireEsc {
+			t.Fatalf("Unexpected card data in the request: %+v", reqCard)
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "234bc93745ac08281fdc7dba4aa4567b"}`))
+	}))
+	defer server.Close()
+
+	card := mercadopago.RequestCardToken{
+		CardNumber:      mercadopago.NewSensitiveString(pan),
+		ExpirationMonth: 11,
+		ExpirationYear:  2030,
+		SecurityCode:    mercadopago.NewSensitiveString("123"),
+		Cardholder:      mercadopago.Cardholder{Name: `APRO "Test"`},
+		RequireEsc:      true,
+	}
+
+	client := mercadopago.NewClient(server.URL+"/", "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344")
+	if _, err := client.GetCardToken(context.Background(), card); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Trim(card.CardNumber.Reveal(), "\x00") != "" || strings.Trim(card.SecurityCode.Reveal(), "\x00") != "" {
+		t.Fatal("Card number and security code must be wiped after sending the request")
+	}
+}
+
+func TestGetCardTokenKeepCardDataWhenNotSent(t *testing.T) {
+
+	pan := "5031755734530604"
+	card := mercadopago.RequestCardToken{
+		CardNumber:      mercadopago.NewSensitiveString(pan),
+		ExpirationMonth: 11,
+		ExpirationYear:  2030,
+		SecurityCode:    mercadopago.NewSensitiveString("123"),
+	}
+
+	client := mercadopago.NewClient(mercadopago.BaseURL, "APP_USR-7237385876897478-882419-cf8589ace9fee57cb876a2dc72ed88a6-57883988")
+	if err := client.SetEnvironment(mercadopago.EnvironmentProduction); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetCardToken(context.Background(), card); !errors.Is(err, mercadopago.ErrProductionNotAllowed) {
+		t.Fatalf("Receive error: %v | must be: %v", err, mercadopago.ErrProductionNotAllowed)
+	}
+	if card.CardNumber.Reveal() != pan || card.SecurityCode.Reveal() != "123" {
+		t.Fatal("Card data must not be wiped when the request isn't sent")
+	}
+
+	saved := mercadopago.RequestSavedCardToken{CardID: "9053893640", SecurityCode: mercadopago.NewSensitiveString("123")}
+	if _, err := client.GetSavedCardToken(context.Background(), saved); !errors.Is(err, mercadopago.ErrProductionNotAllowed) {
+		t.Fatalf("Receive error: %v | must be: %v", err, mercadopago.ErrProductionNotAllowed)
+	}
+	if saved.SecurityCode.Reveal() != "123" {
+		t.Fatal("Security code must not be wiped when the request isn't sent")
+	}
+}
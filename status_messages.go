@@ -0,0 +1,461 @@
+package mercadopago
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Language of the messages shown to the buyer.
+type Language string
+
+const (
+	LanguageSpanish    Language = "es"
+	LanguagePortuguese Language = "pt"
+	LanguageEnglish    Language = "en"
+)
+
+// SiteLanguage return the language of the buyers of the site, like pt for MLB.
+func SiteLanguage(siteID string) Language {
+	if siteID == "MLB" {
+		return LanguagePortuguese
+	}
+	return LanguageSpanish
+}
+
+// LocalizedMessage is a message in every supported language.
+type LocalizedMessage map[Language]string
+
+// In return the message in the language, it accept tags with region like es-AR or pt_BR.
+// When the language isn't supported the message is returned in English.
+func (m LocalizedMessage) In(lang Language) string {
+	tag := strings.ToLower(string(lang))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	if msg, ok := m[Language(tag)]; ok {
+		return msg
+	}
+	return m[LanguageEnglish]
+}
+
+// RecommendedAction is what the buyer should do after the payment status.
+type RecommendedAction string
+
+const (
+	// ActionNone means that the buyer doesn't need to do anything.
+	ActionNone RecommendedAction = "none"
+	// ActionWait means that the payment is still being processed.
+	ActionWait RecommendedAction = "wait"
+	// ActionRetry means that the buyer can pay again, with the same card after checking the data.
+	ActionRetry RecommendedAction = "retry"
+	// ActionUseOtherCard means that the buyer must pay with another card or payment method.
+	ActionUseOtherCard RecommendedAction = "use_other_card"
+	// ActionCallIssuer means that the buyer must call the card issuer to authorize the payment or enable the card.
+	ActionCallIssuer RecommendedAction = "call_issuer"
+)
+
+// StatusDetailMessage is the message and the recommended action for a status detail.
+type StatusDetailMessage struct {
+	Message LocalizedMessage
+	Action  RecommendedAction
+}
+
+var statusDetailMessages = map[PaymentStatusDetail]StatusDetailMessage{
+	StatusDetailAccredited: {Action: ActionNone, Message: LocalizedMessage{
+		LanguageEnglish:    "Your payment was approved.",
+		LanguageSpanish:    "¡Listo! Se acreditó tu pago.",
+		LanguagePortuguese: "Pronto! Seu pagamento foi aprovado.",
+	}},
+	StatusDetailPartiallyRefunded: {Action: ActionNone, Message: LocalizedMessage{
+		LanguageEnglish:    "Part of your payment was refunded.",
+		LanguageSpanish:    "Se devolvió parte de tu pago.",
+		LanguagePortuguese: "Parte do seu pagamento foi devolvida.",
+	}},
+	StatusDetailPendingCapture: {Action: ActionWait, Message: LocalizedMessage{
+		LanguageEnglish:    "Your payment was authorized and will be confirmed shortly.",
+		LanguageSpanish:    "Tu pago fue autorizado y se confirmará en breve.",
+		LanguagePortuguese: "Seu pagamento foi autorizado e será confirmado em breve.",
+	}},
+	StatusDetailPendingContingency: {Action: ActionWait, Message: LocalizedMessage{
+		LanguageEnglish:    "We are processing your payment. We will let you know the result in less than 2 business days.",
+		LanguageSpanish:    "Estamos procesando tu pago. En menos de 2 días hábiles te avisaremos si se acreditó.",
+		LanguagePortuguese: "Estamos processando o seu pagamento. Em até 2 dias úteis informaremos o resultado.",
+	}},
+	StatusDetailPendingReviewManual: {Action: ActionWait, Message: LocalizedMessage{
+		LanguageEnglish:    "We are reviewing your payment. We will let you know the result in less than 2 business days.",
+		LanguageSpanish:    "Estamos revisando tu pago. En menos de 2 días hábiles te avisaremos si se acreditó o si necesitamos más información.",
+		LanguagePortuguese: "Estamos revisando o seu pagamento. Em até 2 dias úteis informaremos se foi aprovado ou se precisamos de mais informações.",
+	}},
+	StatusDetailPendingWaitingPayment: {Action: ActionWait, Message: LocalizedMessage{
+		LanguageEnglish:    "We are waiting for your payment.",
+		LanguageSpanish:    "Estamos esperando tu pago.",
+		LanguagePortuguese: "Estamos aguardando o seu pagamento.",
+	}},
+	StatusDetailPendingWaitingTransfer: {Action: ActionWait, Message: LocalizedMessage{
+		LanguageEnglish:    "We are waiting for the bank transfer.",
+		LanguageSpanish:    "Estamos esperando la transferencia bancaria.",
+		LanguagePortuguese: "Estamos aguardando a transferência bancária.",
+	}},
+	StatusDetailPendingChallenge: {Action: ActionWait, Message: LocalizedMessage{
+		LanguageEnglish:    "Confirm the payment with your bank to finish it.",
+		LanguageSpanish:    "Confirma el pago con tu banco para completarlo.",
+		LanguagePortuguese: "Confirme o pagamento com o seu banco para concluí-lo.",
+	}},
+	StatusDetailExpired: {Action: ActionRetry, Message: LocalizedMessage{
+		LanguageEnglish:    "The payment expired before it was paid.",
+		LanguageSpanish:    "El pago venció antes de ser abonado.",
+		LanguagePortuguese: "O pagamento expirou antes de ser pago.",
+	}},
+	StatusDetailByCollector: {Action: ActionNone, Message: LocalizedMessage{
+		LanguageEnglish:    "The seller cancelled the payment.",
+		LanguageSpanish:    "El vendedor canceló el pago.",
+		LanguagePortuguese: "O vendedor cancelou o pagamento.",
+	}},
+	StatusDetailByPayer: {Action: ActionNone, Message: LocalizedMessage{
+		LanguageEnglish:    "You cancelled the payment.",
+		LanguageSpanish:    "Cancelaste el pago.",
+		LanguagePortuguese: "Você cancelou o pagamento.",
+	}},
+	StatusDetailRefunded: {Action: ActionNone, Message: LocalizedMessage{
+		LanguageEnglish:    "Your payment was refunded.",
+		LanguageSpanish:    "Se devolvió tu pago.",
+		LanguagePortuguese: "Seu pagamento foi devolvido.",
+	}},
+	StatusDetailSettled: {Action: ActionNone, Message: LocalizedMessage{
+		LanguageEnglish:    "The chargeback of the payment was settled.",
+		LanguageSpanish:    "El contracargo del pago fue resuelto.",
+		LanguagePortuguese: "O estorno do pagamento foi resolvido.",
+	}},
+	StatusDetailReimbursed: {Action: ActionNone, Message: LocalizedMessage{
+		LanguageEnglish:    "The chargeback of the payment was reimbursed.",
+		LanguageSpanish:    "El contracargo del pago fue reintegrado.",
+		LanguagePortuguese: "O estorno do pagamento foi reembolsado.",
+	}},
+	StatusDetailInProcess: {Action: ActionWait, Message: LocalizedMessage{
+		LanguageEnglish:    "The dispute of the payment is in process.",
+		LanguageSpanish:    "El reclamo del pago está en proceso.",
+		LanguagePortuguese: "A reclamação do pagamento está em andamento.",
+	}},
+	StatusDetailBadFilledCardNumber: {Action: ActionRetry, Message: LocalizedMessage{
+		LanguageEnglish:    "Check the card number.",
+		LanguageSpanish:    "Revisa el número de tarjeta.",
+		LanguagePortuguese: "Revise o número do cartão.",
+	}},
+	StatusDetailBadFilledDate: {Action: ActionRetry, Message: LocalizedMessage{
+		LanguageEnglish:    "Check the expiration date.",
+		LanguageSpanish:    "Revisa la fecha de vencimiento.",
+		LanguagePortuguese: "Revise a data de vencimento.",
+	}},
+	StatusDetailBadFilledOther: {Action: ActionRetry, Message: LocalizedMessage{
+		LanguageEnglish:    "Check the card data.",
+		LanguageSpanish:    "Revisa los datos de la tarjeta.",
+		LanguagePortuguese: "Revise os dados do cartão.",
+	}},
+	StatusDetailBadFilledSecurityCode: {Action: ActionRetry, Message: LocalizedMessage{
+		LanguageEnglish:    "Check the security code of the card.",
+		LanguageSpanish:    "Revisa el código de seguridad de la tarjeta.",
+		LanguagePortuguese: "Revise o código de segurança do cartão.",
+	}},
+	StatusDetailBlacklist: {Action: ActionUseOtherCard, Message: LocalizedMessage{
+		LanguageEnglish:    "We couldn't process your payment. Use another card or payment method.",
+		LanguageSpanish:    "No pudimos procesar tu pago. Usa otra tarjeta u otro medio de pago.",
+		LanguagePortuguese: "Não conseguimos processar seu pagamento. Use outro cartão ou meio de pagamento.",
+	}},
+	StatusDetailCallForAuthorize: {Action: ActionCallIssuer, Message: LocalizedMessage{
+		LanguageEnglish:    "You must authorize the payment with the card issuer.",
+		LanguageSpanish:    "Debes autorizar el pago ante el emisor de tu tarjeta.",
+		LanguagePortuguese: "Você deve autorizar o pagamento com o emissor do cartão.",
+	}},
+	StatusDetailCardDisabled: {Action: ActionCallIssuer, Message: LocalizedMessage{
+		LanguageEnglish:    "Call the card issuer to activate your card.",
+		LanguageSpanish:    "Llama al emisor de tu tarjeta para activarla.",
+		LanguagePortuguese: "Ligue para o emissor do cartão para ativá-lo.",
+	}},
+	StatusDetailCardError: {Action: ActionRetry, Message: LocalizedMessage{
+		LanguageEnglish:    "We couldn't process your payment. Try again or use another card.",
+		LanguageSpanish:    "No pudimos procesar tu pago. Vuelve a intentarlo o usa otra tarjeta.",
+		LanguagePortuguese: "Não conseguimos processar seu pagamento. Tente novamente ou use outro cartão.",
+	}},
+	StatusDetailDuplicatedPayment: {Action: ActionUseOtherCard, Message: LocalizedMessage{
+		LanguageEnglish:    "You already made a payment for that amount. If you need to pay again, use another card or payment method.",
+		LanguageSpanish:    "Ya hiciste un pago por ese valor. Si necesitas volver a pagar, usa otra tarjeta u otro medio de pago.",
+		LanguagePortuguese: "Você já fez um pagamento com esse valor. Se precisar pagar novamente, use outro cartão ou meio de pagamento.",
+	}},
+	StatusDetailHighRisk: {Action: ActionUseOtherCard, Message: LocalizedMessage{
+		LanguageEnglish:    "Your payment was rejected. Use another payment method.",
+		LanguageSpanish:    "Tu pago fue rechazado. Usa otro medio de pago.",
+		LanguagePortuguese: "Seu pagamento foi recusado. Use outro meio de pagamento.",
+	}},
+	StatusDetailInsufficientAmount: {Action: ActionUseOtherCard, Message: LocalizedMessage{
+		LanguageEnglish:    "Your card doesn't have enough funds.",
+		LanguageSpanish:    "Tu tarjeta no tiene fondos suficientes.",
+		LanguagePortuguese: "O cartão não tem saldo suficiente.",
+	}},
+	StatusDetailInvalidInstallments: {Action: ActionRetry, Message: LocalizedMessage{
+		LanguageEnglish:    "The card doesn't accept payments in that many installments.",
+		LanguageSpanish:    "La tarjeta no acepta pagos en esa cantidad de cuotas.",
+		LanguagePortuguese: "O cartão não aceita pagamentos nesse número de parcelas.",
+	}},
+	StatusDetailMaxAttempts: {Action: ActionUseOtherCard, Message: LocalizedMessage{
+		LanguageEnglish:    "You reached the limit of allowed attempts. Use another card or payment method.",
+		LanguageSpanish:    "Llegaste al límite de intentos permitidos. Usa otra tarjeta u otro medio de pago.",
+		LanguagePortuguese: "Você atingiu o limite de tentativas permitidas. Use outro cartão ou meio de pagamento.",
+	}},
+	StatusDetailOtherReason: {Action: ActionUseOtherCard, Message: LocalizedMessage{
+		LanguageEnglish:    "The card issuer didn't approve the payment. Use another card or payment method.",
+		LanguageSpanish:    "El emisor de tu tarjeta no aprobó el pago. Usa otra tarjeta u otro medio de pago.",
+		LanguagePortuguese: "O emissor do cartão não aprovou o pagamento. Use outro cartão ou meio de pagamento.",
+	}},
+	StatusDetail3DSChallenge: {Action: ActionRetry, Message: LocalizedMessage{
+		LanguageEnglish:    "Your payment was rejected because the card verification failed. Try again.",
+		LanguageSpanish:    "Tu pago fue rechazado porque falló la validación de la tarjeta. Vuelve a intentarlo.",
+		LanguagePortuguese: "Seu pagamento foi recusado porque a validação do cartão falhou. Tente novamente.",
+	}},
+	StatusDetailAmountRateLimit: {Action: ActionUseOtherCard, Message: LocalizedMessage{
+		LanguageEnglish:    "You exceeded the amount limit of the card. Use another card or payment method.",
+		LanguageSpanish:    "Superaste el límite de monto de la tarjeta. Usa otra tarjeta u otro medio de pago.",
+		LanguagePortuguese: "Você ultrapassou o limite de valor do cartão. Use outro cartão ou meio de pagamento.",
+	}},
+	StatusDetailFraud: {Action: ActionUseOtherCard, Message: LocalizedMessage{
+		LanguageEnglish:    "We couldn't process your payment. Use another card or payment method.",
+		LanguageSpanish:    "No pudimos procesar tu pago. Usa otra tarjeta u otro medio de pago.",
+		LanguagePortuguese: "Não conseguimos processar seu pagamento. Use outro cartão ou meio de pagamento.",
+	}},
+	StatusDetailRejectedByBank: {Action: ActionUseOtherCard, Message: LocalizedMessage{
+		LanguageEnglish:    "Your bank rejected the payment. Use another payment method.",
+		LanguageSpanish:    "Tu banco rechazó el pago. Usa otro medio de pago.",
+		LanguagePortuguese: "Seu banco recusou o pagamento. Use outro meio de pagamento.",
+	}},
+	StatusDetailRejectedInsufficientData: {Action: ActionRetry, Message: LocalizedMessage{
+		LanguageEnglish:    "Some required data is missing. Complete your data and try again.",
+		LanguageSpanish:    "Faltan datos obligatorios. Completa tus datos y vuelve a intentarlo.",
+		LanguagePortuguese: "Faltam dados obrigatórios. Complete seus dados e tente novamente.",
+	}},
+	StatusDetailRejectedByRegulations: {Action: ActionUseOtherCard, Message: LocalizedMessage{
+		LanguageEnglish:    "The payment was rejected because of regulations. Use another payment method.",
+		LanguageSpanish:    "El pago fue rechazado por regulaciones. Usa otro medio de pago.",
+		LanguagePortuguese: "O pagamento foi recusado por regulamentações. Use outro meio de pagamento.",
+	}},
+	StatusDetailBankError: {Action: ActionRetry, Message: LocalizedMessage{
+		LanguageEnglish:    "There was an error with your bank. Try again in a few minutes.",
+		LanguageSpanish:    "Hubo un error con tu banco. Vuelve a intentarlo en unos minutos.",
+		LanguagePortuguese: "Houve um erro com seu banco. Tente novamente em alguns minutos.",
+	}},
+}
+
+// unknownStatusDetailMessage is used for the status details that aren't in the catalog.
+var unknownStatusDetailMessage = StatusDetailMessage{Action: ActionNone, Message: LocalizedMessage{
+	LanguageEnglish:    "We couldn't process your payment.",
+	LanguageSpanish:    "No pudimos procesar tu pago.",
+	LanguagePortuguese: "Não conseguimos processar seu pagamento.",
+}}
+
+// unknownRejectionMessage is used for the rejections that aren't in the catalog, it's safer to ask for another
+// card than to retry a rejection that we don't know.
+var unknownRejectionMessage = StatusDetailMessage{Action: ActionUseOtherCard, Message: LocalizedMessage{
+	LanguageEnglish:    "Your payment was rejected. Use another card or payment method.",
+	LanguageSpanish:    "Tu pago fue rechazado. Usa otra tarjeta u otro medio de pago.",
+	LanguagePortuguese: "Seu pagamento foi recusado. Use outro cartão ou meio de pagamento.",
+}}
+
+// unknownPendingMessage is used for the pending status details that aren't in the catalog.
+var unknownPendingMessage = StatusDetailMessage{Action: ActionWait, Message: LocalizedMessage{
+	LanguageEnglish:    "We are processing your payment.",
+	LanguageSpanish:    "Estamos procesando tu pago.",
+	LanguagePortuguese: "Estamos processando o seu pagamento.",
+}}
+
+// Catalog return the message and the recommended action of the status detail, the second value is false
+// when the status detail isn't in the catalog.
+func (d PaymentStatusDetail) Catalog() (StatusDetailMessage, bool) {
+	msg, ok := statusDetailMessages[d]
+	return msg, ok
+}
+
+// catalogOrFallback return the catalog entry of the status detail, or the generic entry that fits it.
+func (d PaymentStatusDetail) catalogOrFallback() StatusDetailMessage {
+	if msg, ok := statusDetailMessages[d]; ok {
+		return msg
+	}
+	switch {
+	case d.IsRejection():
+		return unknownRejectionMessage
+	case strings.HasPrefix(string(d), "pending_"):
+		return unknownPendingMessage
+	}
+	return unknownStatusDetailMessage
+}
+
+// Message return the message for the buyer in the language, with a generic message for the unknown status details.
+func (d PaymentStatusDetail) Message(lang Language) string {
+	return d.catalogOrFallback().Message.In(lang)
+}
+
+// Action return what the buyer should do after the status detail. The unknown rejections recommend another card.
+func (d PaymentStatusDetail) Action() RecommendedAction {
+	return d.catalogOrFallback().Action
+}
+
+// StatusMessage return the message for the buyer and the recommended action of the payment. When the status
+// detail isn't in the catalog the status of the payment is used, so an unknown rejection recommends another card.
+func (p *Payment) StatusMessage(lang Language) (string, RecommendedAction) {
+	msg := p.StatusDetail.catalogOrFallback()
+	if _, ok := statusDetailMessages[p.StatusDetail]; !ok {
+		switch p.Status {
+		case PaymentStatusRejected:
+			msg = unknownRejectionMessage
+		case PaymentStatusPending, PaymentStatusInProcess, PaymentStatusAuthorized:
+			msg = unknownPendingMessage
+		}
+	}
+	return msg.Message.In(lang), msg.Action
+}
+
+var (
+	causeCardNumberRequired = LocalizedMessage{
+		LanguageEnglish:    "Enter your card number.",
+		LanguageSpanish:    "Ingresa el número de tu tarjeta.",
+		LanguagePortuguese: "Digite o número do seu cartão.",
+	}
+	causeIssuerRequired = LocalizedMessage{
+		LanguageEnglish:    "Enter your bank.",
+		LanguageSpanish:    "Ingresa tu banco.",
+		LanguagePortuguese: "Informe seu banco.",
+	}
+	causeCardholderRequired = LocalizedMessage{
+		LanguageEnglish:    "Enter the cardholder name.",
+		LanguageSpanish:    "Ingresa el nombre y apellido.",
+		LanguagePortuguese: "Digite o nome e sobrenome.",
+	}
+	causeDocumentRequired = LocalizedMessage{
+		LanguageEnglish:    "Enter your document number.",
+		LanguageSpanish:    "Ingresa tu documento.",
+		LanguagePortuguese: "Informe seu documento.",
+	}
+	causeDocumentInvalid = LocalizedMessage{
+		LanguageEnglish:    "The document is invalid.",
+		LanguageSpanish:    "El documento es inválido.",
+		LanguagePortuguese: "O documento é inválido.",
+	}
+	causeCardNumberInvalid = LocalizedMessage{
+		LanguageEnglish:    "Enter a valid card number.",
+		LanguageSpanish:    "Ingresa un número de tarjeta válido.",
+		LanguagePortuguese: "Digite um número de cartão válido.",
+	}
+	causeCardTokenInvalid = LocalizedMessage{
+		LanguageEnglish:    "The card data expired, enter it again.",
+		LanguageSpanish:    "Los datos de la tarjeta vencieron, ingrésalos nuevamente.",
+		LanguagePortuguese: "Os dados do cartão expiraram, digite-os novamente.",
+	}
+)
+
+// causeMessages are the messages of the cause codes returned by the card tokens and the payments.
+var causeMessages = map[string]LocalizedMessage{
+	"205": causeCardNumberRequired,
+	"208": {
+		LanguageEnglish:    "Choose the expiration month.",
+		LanguageSpanish:    "Elige un mes.",
+		LanguagePortuguese: "Escolha o mês.",
+	},
+	"209": {
+		LanguageEnglish:    "Choose the expiration year.",
+		LanguageSpanish:    "Elige un año.",
+		LanguagePortuguese: "Escolha o ano.",
+	},
+	"212": {
+		LanguageEnglish:    "Enter your document type.",
+		LanguageSpanish:    "Ingresa tu tipo de documento.",
+		LanguagePortuguese: "Informe seu tipo de documento.",
+	},
+	"213": causeDocumentRequired,
+	"214": causeDocumentRequired,
+	"220": causeIssuerRequired,
+	"221": causeCardholderRequired,
+	"224": {
+		LanguageEnglish:    "Enter the security code.",
+		LanguageSpanish:    "Ingresa el código de seguridad.",
+		LanguagePortuguese: "Digite o código de segurança.",
+	},
+	"E301": causeCardNumberInvalid,
+	"E302": {
+		LanguageEnglish:    "Check the security code.",
+		LanguageSpanish:    "Revisa el código de seguridad.",
+		LanguagePortuguese: "Confira o código de segurança.",
+	},
+	"316": {
+		LanguageEnglish:    "Enter a valid name.",
+		LanguageSpanish:    "Ingresa un nombre válido.",
+		LanguagePortuguese: "Digite um nome válido.",
+	},
+	"322": {
+		LanguageEnglish:    "The document type is invalid.",
+		LanguageSpanish:    "El tipo de documento es inválido.",
+		LanguagePortuguese: "O tipo de documento é inválido.",
+	},
+	"323": {
+		LanguageEnglish:    "Check your document.",
+		LanguageSpanish:    "Revisa tu documento.",
+		LanguagePortuguese: "Confira seu documento.",
+	},
+	"324": causeDocumentInvalid,
+	"325": {
+		LanguageEnglish:    "The expiration month is invalid.",
+		LanguageSpanish:    "El mes es inválido.",
+		LanguagePortuguese: "O mês é inválido.",
+	},
+	"326": {
+		LanguageEnglish:    "The expiration year is invalid.",
+		LanguageSpanish:    "El año es inválido.",
+		LanguagePortuguese: "O ano é inválido.",
+	},
+	"2006": causeCardTokenInvalid,
+	"2067": causeDocumentInvalid,
+	"3000": causeCardholderRequired,
+	"3001": causeIssuerRequired,
+	"3003": causeCardTokenInvalid,
+	"3034": causeCardNumberInvalid,
+	"4033": {
+		LanguageEnglish:    "The card doesn't accept payments in that many installments.",
+		LanguageSpanish:    "La tarjeta no acepta pagos en esa cantidad de cuotas.",
+		LanguagePortuguese: "O cartão não aceita pagamentos nesse número de parcelas.",
+	},
+}
+
+// CauseMessage return the message for the buyer of a cause code in the language, the second value is false
+// when the code isn't in the catalog.
+func CauseMessage(code string, lang Language) (string, bool) {
+	msg, ok := causeMessages[code]
+	if !ok {
+		return "", false
+	}
+	return msg.In(lang), true
+}
+
+// CauseCodes return the codes of the causes of the error, the API return them as numbers or strings.
+func (e *ErrorResponse) CauseCodes() []string {
+	var codes []string
+	for _, cause := range e.Cause {
+		c, ok := cause.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch code := c["code"].(type) {
+		case string:
+			codes = append(codes, code)
+		case float64:
+			codes = append(codes, fmt.Sprintf("%.0f", code))
+		}
+	}
+	return codes
+}
+
+// LocalizedMessages return the messages for the buyer of the causes of the error that are in the catalog.
+func (e *ErrorResponse) LocalizedMessages(lang Language) []string {
+	var messages []string
+	for _, code := range e.CauseCodes() {
+		if msg, ok := CauseMessage(code, lang); ok && !contains(messages, msg) {
+			messages = append(messages, msg)
+		}
+	}
+	return messages
+}
@@ -0,0 +1,184 @@
+package mercadopago_test
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestStatusDetailCatalog(t *testing.T) {
+
+	details := declaredStatusDetails(t)
+	if len(details) < 36 {
+		t.Fatalf("Expected all the status details declared in payment_status.go but found %d", len(details))
+	}
+	languages := []mercadopago.Language{mercadopago.LanguageSpanish, mercadopago.LanguagePortuguese, mercadopago.LanguageEnglish}
+
+	for _, detail := range details {
+		msg, ok := detail.Catalog()
+		if !ok {
+			t.Fatalf("Status detail %s is not in the catalog", detail)
+		}
+		for _, lang := range languages {
+			if msg.Message[lang] == "" {
+				t.Fatalf("Status detail %s doesn't have a message in %s", detail, lang)
+			}
+		}
+		if msg.Action == "" {
+			t.Fatalf("Status detail %s doesn't have a recommended action", detail)
+		}
+		if detail.IsRetryableDecline() && (msg.Action == mercadopago.ActionNone || msg.Action == mercadopago.ActionWait) {
+			t.Fatalf("Retryable decline %s must recommend an action to the buyer", detail)
+		}
+	}
+
+	tests := []struct {
+		detail   mercadopago.PaymentStatusDetail
+		lang     mercadopago.Language
+		expected string
+		action   mercadopago.RecommendedAction
+	}{
+		{
+			detail:   mercadopago.StatusDetailBadFilledSecurityCode,
+			lang:     "es-AR",
+			expected: "Revisa el código de seguridad de la tarjeta.",
+			action:   mercadopago.ActionRetry,
+		},
+		{
+			detail:   mercadopago.StatusDetailCallForAuthorize,
+			lang:     "pt_BR",
+			expected: "Você deve autorizar o pagamento com o emissor do cartão.",
+			action:   mercadopago.ActionCallIssuer,
+		},
+		{
+			detail:   mercadopago.StatusDetailHighRisk,
+			lang:     "fr",
+			expected: "Your payment was rejected. Use another payment method.",
+			action:   mercadopago.ActionUseOtherCard,
+		},
+		{
+			detail:   "cc_rejected_unknown",
+			lang:     mercadopago.LanguageSpanish,
+			expected: "Tu pago fue rechazado. Usa otra tarjeta u otro medio de pago.",
+			action:   mercadopago.ActionUseOtherCard,
+		},
+		{
+			detail:   "rejected_unknown",
+			lang:     mercadopago.LanguageEnglish,
+			expected: "Your payment was rejected. Use another card or payment method.",
+			action:   mercadopago.ActionUseOtherCard,
+		},
+		{
+			detail:   "pending_unknown",
+			lang:     mercadopago.LanguageEnglish,
+			expected: "We are processing your payment.",
+			action:   mercadopago.ActionWait,
+		},
+		{
+			detail:   "unknown",
+			lang:     mercadopago.LanguageSpanish,
+			expected: "No pudimos procesar tu pago.",
+			action:   mercadopago.ActionNone,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := tt.detail.Message(tt.lang); got != tt.expected {
+			t.Fatalf("Expected message %q but receive %q", tt.expected, got)
+		}
+		if got := tt.detail.Action(); got != tt.action {
+			t.Fatalf("Expected action %s but receive %s", tt.action, got)
+		}
+	}
+
+	payment := mercadopago.Payment{Status: mercadopago.PaymentStatusRejected, StatusDetail: "unknown"}
+	if msg, action := payment.StatusMessage(mercadopago.LanguageEnglish); action != mercadopago.ActionUseOtherCard || msg != "Your payment was rejected. Use another card or payment method." {
+		t.Fatalf("Unexpected message of an unknown rejection: %s %s", msg, action)
+	}
+	payment = mercadopago.Payment{Status: mercadopago.PaymentStatusRejected, StatusDetail: mercadopago.StatusDetailCallForAuthorize}
+	if _, action := payment.StatusMessage(mercadopago.LanguageEnglish); action != mercadopago.ActionCallIssuer {
+		t.Fatalf("Expected action %s but receive %s", mercadopago.ActionCallIssuer, action)
+	}
+
+	if mercadopago.SiteLanguage("MLB") != mercadopago.LanguagePortuguese || mercadopago.SiteLanguage("MLA") != mercadopago.LanguageSpanish {
+		t.Fatal("Unexpected site language")
+	}
+}
+
+func TestErrorResponseLocalizedMessages(t *testing.T) {
+
+	body := `{
+		"message": "invalid parameters",
+		"error": "bad_request",
+		"status": 400,
+		"cause": [
+			{"code": "E301", "description": "invalid parameter cardNumber"},
+			{"code": 2067, "description": "Invalid user identification number"},
+			{"code": "324", "description": "invalid parameter cardholder.identification.number"},
+			{"code": 9999, "description": "unknown"}
+		]
+	}`
+
+	var errRes mercadopago.ErrorResponse
+	if err := json.Unmarshal([]byte(body), &errRes); err != nil {
+		t.Fatal(err)
+	}
+
+	codes := errRes.CauseCodes()
+	if !reflect.DeepEqual(codes, []string{"E301", "2067", "324", "9999"}) {
+		t.Fatalf("Unexpected cause codes %v", codes)
+	}
+
+	expected := []string{"Ingresa un número de tarjeta válido.", "El documento es inválido."}
+	if got := errRes.LocalizedMessages(mercadopago.LanguageSpanish); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected messages %v but receive %v", expected, got)
+	}
+
+	if _, ok := mercadopago.CauseMessage("9999", mercadopago.LanguageEnglish); ok {
+		t.Fatal("Expected unknown cause code")
+	}
+}
+
+// declaredStatusDetails return all the PaymentStatusDetail constants declared in payment_status.go, so a new
+// constant without an entry in the catalog fails the test.
+func declaredStatusDetails(t *testing.T) []mercadopago.PaymentStatusDetail {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "payment_status.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var details []mercadopago.PaymentStatusDetail
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			ident, ok := value.Type.(*ast.Ident)
+			if !ok || ident.Name != "PaymentStatusDetail" {
+				continue
+			}
+			for _, v := range value.Values {
+				lit, ok := v.(*ast.BasicLit)
+				if !ok {
+					t.Fatalf("Unexpected value of a status detail: %T", v)
+				}
+				detail, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					t.Fatal(err)
+				}
+				details = append(details, mercadopago.PaymentStatusDetail(detail))
+			}
+		}
+	}
+	return details
+}
@@ -0,0 +1,188 @@
+// Package testcards has the test cards published by Mercado Pago for every site, and the cardholder names
+// that force the result of a payment in the sandbox, so the integration tests can exercise every rejection path.
+//
+// The cards only work with test credentials, see: https://www.mercadopago.com/developers/en/docs/checkout-api/integration-test/test-cards
+package testcards
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackgris/mercadopago"
+)
+
+// Outcome is the cardholder name that force the result of the payment.
+type Outcome string
+
+const (
+	Approved            Outcome = "APRO"
+	RejectedOther       Outcome = "OTHE"
+	Pending             Outcome = "CONT"
+	CallForAuthorize    Outcome = "CALL"
+	InsufficientAmount  Outcome = "FUND"
+	InvalidSecurityCode Outcome = "SECU"
+	InvalidExpiration   Outcome = "EXPI"
+	InvalidForm         Outcome = "FORM"
+)
+
+// Outcomes are all the outcomes that can be forced in the sandbox.
+var Outcomes = []Outcome{
+	Approved,
+	RejectedOther,
+	Pending,
+	CallForAuthorize,
+	InsufficientAmount,
+	InvalidSecurityCode,
+	InvalidExpiration,
+	InvalidForm,
+}
+
+// Result is the status and status detail of a payment made with the outcome.
+type Result struct {
+	Status       mercadopago.PaymentStatus
+	StatusDetail mercadopago.PaymentStatusDetail
+}
+
+var results = map[Outcome]Result{
+	Approved:            {Status: mercadopago.PaymentStatusApproved, StatusDetail: mercadopago.StatusDetailAccredited},
+	RejectedOther:       {Status: mercadopago.PaymentStatusRejected, StatusDetail: mercadopago.StatusDetailOtherReason},
+	Pending:             {Status: mercadopago.PaymentStatusInProcess, StatusDetail: mercadopago.StatusDetailPendingContingency},
+	CallForAuthorize:    {Status: mercadopago.PaymentStatusRejected, StatusDetail: mercadopago.StatusDetailCallForAuthorize},
+	InsufficientAmount:  {Status: mercadopago.PaymentStatusRejected, StatusDetail: mercadopago.StatusDetailInsufficientAmount},
+	InvalidSecurityCode: {Status: mercadopago.PaymentStatusRejected, StatusDetail: mercadopago.StatusDetailBadFilledSecurityCode},
+	InvalidExpiration:   {Status: mercadopago.PaymentStatusRejected, StatusDetail: mercadopago.StatusDetailBadFilledDate},
+	InvalidForm:         {Status: mercadopago.PaymentStatusRejected, StatusDetail: mercadopago.StatusDetailBadFilledOther},
+}
+
+// Expected return the status and status detail of a payment made with the outcome.
+func (o Outcome) Expected() Result {
+	return results[o]
+}
+
+type Card struct {
+	SiteID          string
+	Brand           string
+	Debit           bool
+	Number          string
+	SecurityCode    string
+	ExpirationMonth int
+	ExpirationYear  int
+}
+
+// All the test cards share the same expiration date.
+const (
+	expirationMonth = 11
+	expirationYear  = 2030
+)
+
+var catalog = map[string][]Card{
+	"MLA": {
+		{Brand: "master", Number: "5031755734530604", SecurityCode: "123"},
+		{Brand: "visa", Number: "4509953566233704", SecurityCode: "123"},
+		{Brand: "amex", Number: "371180303257522", SecurityCode: "1234"},
+		{Brand: "debmaster", Debit: true, Number: "5287338310253304", SecurityCode: "123"},
+		{Brand: "debvisa", Debit: true, Number: "4002768694395619", SecurityCode: "123"},
+	},
+	"MLB": {
+		{Brand: "master", Number: "5031433215406351", SecurityCode: "123"},
+		{Brand: "visa", Number: "4235647728025682", SecurityCode: "123"},
+		{Brand: "amex", Number: "375365153556885", SecurityCode: "1234"},
+		{Brand: "elo", Debit: true, Number: "5067766783888311", SecurityCode: "123"},
+	},
+	"MLM": {
+		{Brand: "master", Number: "5474925432670366", SecurityCode: "123"},
+		{Brand: "visa", Number: "4075595716483764", SecurityCode: "123"},
+		{Brand: "debmaster", Debit: true, Number: "5579053461482647", SecurityCode: "123"},
+		{Brand: "debvisa", Debit: true, Number: "4189141221267633", SecurityCode: "123"},
+	},
+	"MLC": {
+		{Brand: "master", Number: "5416752602582580", SecurityCode: "123"},
+		{Brand: "visa", Number: "4168818844447115", SecurityCode: "123"},
+		{Brand: "amex", Number: "375778174461804", SecurityCode: "1234"},
+		{Brand: "redcompra", Debit: true, Number: "4023653523914373", SecurityCode: "123"},
+	},
+	"MCO": {
+		{Brand: "master", Number: "5254133674403564", SecurityCode: "123"},
+		{Brand: "visa", Number: "4013540682746260", SecurityCode: "123"},
+		{Brand: "amex", Number: "374378187755283", SecurityCode: "1234"},
+	},
+	"MPE": {
+		{Brand: "master", Number: "5031755734530604", SecurityCode: "123"},
+		{Brand: "visa", Number: "4009175332806176", SecurityCode: "123"},
+	},
+	"MLU": {
+		{Brand: "master", Number: "5808887774641586", SecurityCode: "123"},
+		{Brand: "visa", Number: "4157236211736486", SecurityCode: "123"},
+	},
+}
+
+// identifications are the documents accepted by the sandbox for the cardholder of every site.
+var identifications = map[string]mercadopago.Identification{
+	"MLA": {Type: "DNI", Number: "12345678"},
+	"MLB": {Type: "CPF", Number: "12345678909"},
+	"MLC": {Type: "Otro", Number: "123456789"},
+	"MCO": {Type: "CC", Number: "123456789"},
+	"MPE": {Type: "DNI", Number: "12345678"},
+	"MLU": {Type: "CI", Number: "12345672"},
+}
+
+// Cards return the test cards of the site.
+func Cards(siteID string) []Card {
+	cards := make([]Card, 0, len(catalog[siteID]))
+	for _, card := range catalog[siteID] {
+		card.SiteID = siteID
+		card.ExpirationMonth = expirationMonth
+		card.ExpirationYear = expirationYear
+		cards = append(cards, card)
+	}
+	return cards
+}
+
+// Find return the test card of the site and brand, the brand is the payment method ID like visa or master.
+func Find(siteID, brand string) (Card, error) {
+	if _, ok := catalog[siteID]; !ok {
+		return Card{}, fmt.Errorf("testcards: unknown site ID %q", siteID)
+	}
+	for _, card := range Cards(siteID) {
+		if card.Brand == brand {
+			return card, nil
+		}
+	}
+	return Card{}, fmt.Errorf("testcards: there is no %s test card for site %s", brand, siteID)
+}
+
+// Request return the request to tokenize the card forcing the outcome of the payment.
+func (c Card) Request(outcome Outcome) (mercadopago.RequestCardToken, error) {
+	if _, ok := results[outcome]; !ok {
+		return mercadopago.RequestCardToken{}, fmt.Errorf("testcards: unknown outcome %q", outcome)
+	}
+
+	return mercadopago.RequestCardToken{
+		CardNumber:      mercadopago.NewSensitiveString(c.Number),
+		ExpirationMonth: c.ExpirationMonth,
+		ExpirationYear:  c.ExpirationYear,
+		SecurityCode:    mercadopago.NewSensitiveString(c.SecurityCode),
+		Cardholder: mercadopago.Cardholder{
+			Name:           string(outcome),
+			Identification: identifications[c.SiteID],
+		},
+	}, nil
+}
+
+// Request return the request to tokenize the test card of the site and brand forcing the outcome of the payment.
+func Request(siteID, brand string, outcome Outcome) (mercadopago.RequestCardToken, error) {
+	card, err := Find(siteID, brand)
+	if err != nil {
+		return mercadopago.RequestCardToken{}, err
+	}
+	return card.Request(outcome)
+}
+
+// CreateToken create the card token of the test card with the client, it must use test credentials.
+func CreateToken(ctx context.Context, client *mercadopago.Client, siteID, brand string, outcome Outcome) (*mercadopago.CardToken, error) {
+	req, err := Request(siteID, brand, outcome)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCardToken(ctx, req)
+}
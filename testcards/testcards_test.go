@@ -0,0 +1,112 @@
+package testcards_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+	"github.com/jackgris/mercadopago/testcards"
+)
+
+func TestCardsAreValid(t *testing.T) {
+
+	data, err := os.ReadFile("../testdata/payment_methods_data.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var paymentMethods mercadopago.PaymentMethods
+	if err := json.Unmarshal(data, &paymentMethods); err != nil {
+		t.Fatal(err)
+	}
+
+	// The payment methods of the test data are from Argentina
+	for _, card := range testcards.Cards("MLA") {
+		for _, outcome := range testcards.Outcomes {
+			req, err := card.Request(outcome)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := mercadopago.ValidateCard(req, paymentMethods); err != nil {
+				t.Fatalf("Card %s should be valid but receive: %s", card.Brand, err)
+			}
+			if err := req.ValidateCardholder("MLA"); err != nil {
+				t.Fatalf("Cardholder of card %s should be valid but receive: %s", card.Brand, err)
+			}
+		}
+	}
+}
+
+func TestRequest(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		siteID      string
+		brand       string
+		outcome     testcards.Outcome
+		expectedErr bool
+	}{
+		{name: "Approved visa in Brazil", siteID: "MLB", brand: "visa", outcome: testcards.Approved},
+		{name: "Rejected master in Mexico", siteID: "MLM", brand: "master", outcome: testcards.InsufficientAmount},
+		{name: "Unknown site", siteID: "SLA", brand: "visa", outcome: testcards.Approved, expectedErr: true},
+		{name: "Unknown brand", siteID: "MLU", brand: "amex", outcome: testcards.Approved, expectedErr: true},
+		{name: "Unknown outcome", siteID: "MLA", brand: "visa", outcome: "NOPE", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testcards.Request(tt.siteID, tt.brand, tt.outcome)
+			if tt.expectedErr {
+				if err == nil {
+					t.Fatal("Expected an error but receive nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Cardholder.Name != string(tt.outcome) {
+				t.Fatalf("Expected cardholder name %s but receive %s", tt.outcome, got.Cardholder.Name)
+			}
+		})
+	}
+
+	if testcards.InsufficientAmount.Expected().StatusDetail != "cc_rejected_insufficient_amount" {
+		t.Fatal("Unexpected status detail for FUND outcome")
+	}
+}
+
+func TestCreateToken(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req mercadopago.RequestCardToken
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if req.Cardholder.Name != string(testcards.CallForAuthorize) {
+			t.Fatalf("Expected cardholder name %s but receive %s", testcards.CallForAuthorize, req.Cardholder.Name)
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "234bc93745ac08281fdc7dba4aa4567b", "status": "active", "live_mode": false}`))
+	}))
+	defer server.Close()
+
+	client := mercadopago.NewClient(server.URL+"/", "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344")
+	if err := client.SetEnvironment(mercadopago.EnvironmentSandbox); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := testcards.CreateToken(context.Background(), client, "MLA", "visa", testcards.CallForAuthorize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID == "" {
+		t.Fatal("Expected a card token ID")
+	}
+}
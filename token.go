@@ -0,0 +1,71 @@
+package mercadopago
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+type TokenType string
+
+const (
+	TokenTypeTest       TokenType = "TEST"
+	TokenTypeProduction TokenType = "APP_USR"
+)
+
+// TokenInfo is the information we can get from an access token, the fields User and LiveMode
+// are only filled when the token was verified against the API.
+type TokenInfo struct {
+	Type     TokenType
+	UserID   int
+	User     *User
+	LiveMode bool
+}
+
+// ParseToken classify an access token without calling the API.
+// The tokens have the form: PREFIX-APP_ID-DATE-HASH-USER_ID
+// For example: TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344
+func ParseToken(token string) (*TokenInfo, error) {
+	var info TokenInfo
+	switch {
+	case strings.HasPrefix(token, string(TokenTypeTest)+"-"):
+		info.Type = TokenTypeTest
+	case strings.HasPrefix(token, string(TokenTypeProduction)+"-"):
+		info.Type = TokenTypeProduction
+	default:
+		return nil, ErrInvalidToken
+	}
+
+	i := strings.LastIndex(token, "-")
+	userID, err := strconv.Atoi(token[i+1:])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	info.UserID = userID
+
+	return &info, nil
+}
+
+// IsTest report if the token is a sandbox token.
+func (t *TokenInfo) IsTest() bool {
+	return t.Type == TokenTypeTest
+}
+
+// InspectToken parse the token used by the client and verify it against the API.
+// The live mode is false when the token has the TEST prefix or when it belongs to a test user.
+func (c *Client) InspectToken(ctx context.Context) (*TokenInfo, error) {
+	info, err := ParseToken(c.token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := c.Me(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info.User = user
+	info.LiveMode = info.Type == TokenTypeProduction && !user.IsTestUser()
+
+	return info, nil
+}
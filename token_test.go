@@ -0,0 +1,91 @@
+package mercadopago_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestParseToken(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		token        string
+		expectedType mercadopago.TokenType
+		expectedUser int
+		expectedErr  error
+	}{
+		{
+			name:         "Test token",
+			token:        "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344",
+			expectedType: mercadopago.TokenTypeTest,
+			expectedUser: 470823344,
+		},
+		{
+			name:         "Production token",
+			token:        "APP_USR-7237385876897478-882419-cf8589ace9fee57cb876a2dc72ed88a6-57883988",
+			expectedType: mercadopago.TokenTypeProduction,
+			expectedUser: 57883988,
+		},
+		{
+			name:        "Unknown prefix",
+			token:       "PROD-7237385876897478-882419-cf8589ace9fee57cb876a2dc72ed88a6-57883988",
+			expectedErr: mercadopago.ErrInvalidToken,
+		},
+		{
+			name:        "Without user ID",
+			token:       "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4",
+			expectedErr: mercadopago.ErrInvalidToken,
+		},
+		{
+			name:        "Empty token",
+			token:       "",
+			expectedErr: mercadopago.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mercadopago.ParseToken(tt.token)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Receive error: %v | must be: %v", err, tt.expectedErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Type != tt.expectedType {
+				t.Fatalf("Expected token type is %s but receive %s", tt.expectedType, got.Type)
+			}
+			if got.UserID != tt.expectedUser {
+				t.Fatalf("Expected user ID is %d but receive %d", tt.expectedUser, got.UserID)
+			}
+		})
+	}
+}
+
+func TestInspectToken(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id": 57883988, "site_id": "MLA", "tags": ["normal"]}`))
+	}))
+	defer server.Close()
+
+	client := mercadopago.NewClient(server.URL+"/", "APP_USR-7237385876897478-882419-cf8589ace9fee57cb876a2dc72ed88a6-57883988")
+	info, err := client.InspectToken(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.LiveMode {
+		t.Fatal("Production token of a real user should be in live mode")
+	}
+	if info.User.SiteID != "MLA" {
+		t.Fatalf("Expected site ID is MLA but receive %s", info.User.SiteID)
+	}
+}
@@ -0,0 +1,69 @@
+package mercadopago
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type User struct {
+	ID               int      `json:"id"`
+	Nickname         string   `json:"nickname"`
+	RegistrationDate string   `json:"registration_date"`
+	FirstName        string   `json:"first_name"`
+	LastName         string   `json:"last_name"`
+	CountryID        string   `json:"country_id"`
+	Email            string   `json:"email"`
+	SiteID           string   `json:"site_id"`
+	UserType         string   `json:"user_type"`
+	Tags             []string `json:"tags"`
+	Status           struct {
+		SiteStatus string `json:"site_status"`
+	} `json:"status"`
+}
+
+// IsTestUser report if the user was created with the test user endpoint.
+func (u *User) IsTestUser() bool {
+	for _, tag := range u.Tags {
+		if tag == "test_user" {
+			return true
+		}
+	}
+	return false
+}
+
+// Me return the user that owns the access token used by the client.
+func (c *Client) Me(ctx context.Context) (*User, error) {
+
+	url := fmt.Sprintf("%susers/me", c.BaseURL)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusBadRequest {
+		var errRes ErrorResponse
+		if err = json.NewDecoder(res.Body).Decode(&errRes); err == nil {
+			return nil, &errRes
+		}
+
+		return nil, fmt.Errorf("unknown error, status code: %d", res.StatusCode)
+	}
+
+	var user User
+	if err := json.NewDecoder(res.Body).Decode(&user); err != nil {
+		return nil, errors.New("Can't parse response")
+	}
+
+	return &user, nil
+}
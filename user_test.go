@@ -0,0 +1,107 @@
+package mercadopago_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackgris/mercadopago"
+)
+
+func TestMe(t *testing.T) {
+
+	var rightResponse string = `
+         {
+           "id": 470823344,
+           "nickname": "TESTUSER1122246296",
+           "registration_date": "2023-08-03T18:09:21.000-04:00",
+           "first_name": "Test",
+           "last_name": "Test",
+           "country_id": "AR",
+           "email": "test_user_7893726298@testuser.com",
+           "site_id": "MLA",
+           "user_type": "normal",
+           "tags": ["normal", "test_user"],
+           "status": {
+             "site_status": "active"
+           }
+         }
+        `
+	var response mercadopago.User
+	if err := json.NewDecoder(strings.NewReader(rightResponse)).Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+	accessToken := "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-470823344"
+	ctx := context.Background()
+
+	tests := []struct {
+		name             string
+		accessToken      string
+		expectedResponse *mercadopago.User
+		expectedErr      *mercadopago.ErrorResponse
+	}{
+		{
+			name:             "Successful response",
+			accessToken:      accessToken,
+			expectedResponse: &response,
+			expectedErr:      nil,
+		},
+		{
+			name:             "Invalid Access Token",
+			accessToken:      "TEST-7237123416497470-080318-abc3babd65d6d886dd1193889f2b85a4-4708233",
+			expectedResponse: nil,
+			expectedErr: &mercadopago.ErrorResponse{
+				Message: "invalid access token",
+				Errors:  "unauthorized",
+				Status:  http.StatusUnauthorized,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+
+		t.Run(tt.name, func(t *testing.T) {
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if http.MethodGet != r.Method {
+					t.Fatalf("HTTP Method expected is %s but receive %s", http.MethodGet, r.Method)
+				}
+				urlPAth := "/users/me"
+				if urlPAth != r.URL.Path {
+					t.Fatalf("Path URL expected is %s but receive %s", urlPAth, r.URL.Path)
+				}
+
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				expectedToken := "Bearer " + accessToken
+				receivedToken := r.Header.Get("Authorization")
+				if expectedToken != receivedToken {
+					w.WriteHeader(tt.expectedErr.Status)
+					data, _ := json.Marshal(tt.expectedErr)
+					_, _ = w.Write(data)
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(rightResponse))
+			}))
+			defer server.Close()
+
+			client := mercadopago.NewClient(server.URL+"/", tt.accessToken)
+			got, err := client.Me(ctx)
+			if err != nil && errors.Is(err, tt.expectedErr) {
+				t.Fatalf("Receive error: %s | must be: %s", err, tt.expectedErr)
+			}
+			if !reflect.DeepEqual(got, tt.expectedResponse) {
+				t.Fatalf("Expected result is %v but receive %v", tt.expectedResponse, got)
+			}
+			if got != nil && !got.IsTestUser() {
+				t.Fatal("User should be a test user")
+			}
+		})
+	}
+}